@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-networking-calico/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/admission/validator"
 	calicoinstall "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/install"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
@@ -42,8 +43,9 @@ var log = logf.Log.WithName("gardener-extension-admission-calico")
 // NewAdmissionCommand creates a new command for running a Calico admission webhook.
 func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 	var (
-		restOpts = &controllercmd.RESTOptions{}
-		mgrOpts  = &controllercmd.ManagerOptions{
+		restOpts       = &controllercmd.RESTOptions{}
+		configFileOpts = &admissioncmd.ConfigOptions{}
+		mgrOpts        = &controllercmd.ManagerOptions{
 			LeaderElection:          true,
 			LeaderElectionID:        controllercmd.LeaderElectionNameID(AdmissionName),
 			LeaderElectionNamespace: os.Getenv("LEADER_ELECTION_NAMESPACE"),
@@ -69,6 +71,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		aggOption = controllercmd.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			configFileOpts,
 			webhookOptions,
 		)
 	)
@@ -90,6 +93,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("error completing options: %w", err)
 			}
 
+			configFileOpts.ApplyValidatorOptions(&validator.DefaultAddOptions)
+
 			util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfig.ClientConnectionConfiguration{
 				QPS:   100.0,
 				Burst: 130,
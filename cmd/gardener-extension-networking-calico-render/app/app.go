@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config/loader"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+	calicocontroller "github.com/gardener/gardener-extension-networking-calico/pkg/controller"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
+)
+
+const (
+	// OutputManifests prints the rendered manifests of the calico chart.
+	OutputManifests = "manifests"
+	// OutputValues prints the values of the calico chart.
+	OutputValues = "values"
+)
+
+// Options are the options of the render command.
+type Options struct {
+	// NetworkFile is the path of the Network manifest.
+	NetworkFile string
+	// ClusterFile is the path of the Cluster manifest.
+	ClusterFile string
+	// ConfigFile is the path of the ControllerConfiguration of the extension, if any.
+	ConfigFile string
+	// Output is the output format, either OutputManifests or OutputValues.
+	Output string
+}
+
+// AddFlags adds the flags of the options to the given command.
+func (o *Options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.NetworkFile, "network", "", "path of the Network manifest")
+	cmd.Flags().StringVar(&o.ClusterFile, "cluster", "", "path of the Cluster manifest")
+	cmd.Flags().StringVar(&o.ConfigFile, "config-file", "", "path of the ControllerConfiguration of the extension (optional)")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", OutputManifests, fmt.Sprintf("output format, one of %q or %q", OutputManifests, OutputValues))
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.NetworkFile == "" || o.ClusterFile == "" {
+		return fmt.Errorf("--network and --cluster are required")
+	}
+	if o.Output != OutputManifests && o.Output != OutputValues {
+		return fmt.Errorf("unsupported output format %q, supported formats are %q and %q", o.Output, OutputManifests, OutputValues)
+	}
+	return nil
+}
+
+// NewRenderCommand creates a new command which renders the calico ManagedResource for a Network resource without
+// accessing the seed or the shoot cluster.
+func NewRenderCommand(out io.Writer) *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("render-%s", calico.Name),
+		Short: "Renders the calico ManagedResource for a Network resource offline",
+		Long: `Runs the reconciliation of the given Network resource up to the rendering of the calico chart and prints the
+rendered manifests or the chart values. The settings derived from the shoot, like the autodetection methods, the pool
+modes and the backend, are printed as a comment in front of it. Migrations of the pod network and rotations of the
+WireGuard keys are not considered, i.e. the desired state of the pod network is rendered.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return run(out, opts)
+		},
+	}
+
+	opts.AddFlags(cmd)
+
+	return cmd
+}
+
+func run(out io.Writer, opts *Options) error {
+	addOpts := calicocontroller.AddOptions{}
+	if opts.ConfigFile != "" {
+		config, err := loader.LoadFromFile(opts.ConfigFile)
+		if err != nil {
+			return fmt.Errorf("failed to load controller configuration: %w", err)
+		}
+		if err := features.FeatureGate.SetFromMap(config.FeatureGates); err != nil {
+			return fmt.Errorf("failed to set feature gates: %w", err)
+		}
+		addOpts.UnderlayMTUs = config.UnderlayMTUs
+		addOpts.Defaults = config.Defaults
+		addOpts.Constraints = config.Constraints
+		addOpts.Profiles = config.Profiles
+	}
+
+	network := &extensionsv1alpha1.Network{}
+	if err := readManifest(opts.NetworkFile, network); err != nil {
+		return err
+	}
+
+	cluster, err := readCluster(opts.ClusterFile)
+	if err != nil {
+		return err
+	}
+
+	result, err := calicocontroller.Render(network, cluster, addOpts)
+	if err != nil {
+		return fmt.Errorf("failed to render calico chart: %w", err)
+	}
+
+	status, err := yaml.Marshal(result.Status)
+	if err != nil {
+		return fmt.Errorf("failed to marshal network status: %w", err)
+	}
+	if _, err := fmt.Fprintln(out, "# Derived network status:"); err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(status))
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(out, "#   %s\n", scanner.Text()); err != nil {
+			return err
+		}
+	}
+
+	if opts.Output == OutputValues {
+		values, err := yaml.Marshal(result.Values)
+		if err != nil {
+			return fmt.Errorf("failed to marshal chart values: %w", err)
+		}
+		_, err = out.Write(values)
+		return err
+	}
+
+	_, err = out.Write(result.Manifests)
+	return err
+}
+
+// readManifest reads the YAML or JSON manifest from the given path into the given object.
+func readManifest(path string, obj interface{}) error {
+	data, err := os.ReadFile(path) // #nosec: G304 -- reading the given manifests is the purpose of the command.
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// readCluster reads the Cluster manifest from the given path and decodes the shoot it contains.
+func readCluster(path string) (*extensionscontroller.Cluster, error) {
+	cluster := &extensionsv1alpha1.Cluster{}
+	if err := readManifest(path, cluster); err != nil {
+		return nil, err
+	}
+
+	if cluster.Spec.Shoot.Raw == nil {
+		return nil, fmt.Errorf("cluster %s does not contain a shoot", path)
+	}
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := json.Unmarshal(cluster.Spec.Shoot.Raw, shoot); err != nil {
+		return nil, fmt.Errorf("failed to decode shoot of cluster %s: %w", path, err)
+	}
+
+	return &extensionscontroller.Cluster{
+		ObjectMeta: cluster.ObjectMeta,
+		Shoot:      shoot,
+	}, nil
+}
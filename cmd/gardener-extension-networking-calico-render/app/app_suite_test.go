@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"flag"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
+)
+
+// update regenerates the golden files in the testdata directory, e.g. with `go test ./... -args -update`.
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestApp(t *testing.T) {
+	features.RegisterFeatureGates()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Calico Render Command Test Suite")
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-networking-calico/cmd/gardener-extension-networking-calico-render/app"
+)
+
+var _ = Describe("Render command", func() {
+	var (
+		out  *bytes.Buffer
+		args []string
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		args = []string{
+			"--network", filepath.Join("testdata", "network.yaml"),
+			"--cluster", filepath.Join("testdata", "cluster.yaml"),
+			"--config-file", filepath.Join("testdata", "config.yaml"),
+		}
+	})
+
+	execute := func(args ...string) error {
+		cmd := NewRenderCommand(out)
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	It("should print the derived status and the chart values", func() {
+		Expect(execute(append(args, "--output", OutputValues)...)).To(Succeed())
+
+		golden := filepath.Join("testdata", "values.golden")
+		if *update {
+			Expect(os.WriteFile(golden, out.Bytes(), 0600)).To(Succeed())
+		}
+		expected, err := os.ReadFile(golden)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal(string(expected)))
+	})
+
+	It("should print the derived status and the rendered manifests", func() {
+		Expect(execute(args...)).To(Succeed())
+
+		Expect(out.String()).To(HavePrefix("# Derived network status:\n"))
+		Expect(out.String()).To(ContainSubstring("#   profile:\n#     name: no-overlay\n#     version: v1\n"))
+		Expect(out.String()).To(ContainSubstring("kind: DaemonSet"))
+	})
+
+	It("should fail without a cluster", func() {
+		Expect(execute("--network", filepath.Join("testdata", "network.yaml"))).To(MatchError("--network and --cluster are required"))
+	})
+
+	It("should fail for an unsupported output format", func() {
+		Expect(execute(append(args, "--output", "json")...)).To(MatchError(ContainSubstring(`unsupported output format "json"`)))
+	})
+})
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gardener/gardener-extension-networking-calico/cmd/gardener-extension-networking-calico-render/app"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
+)
+
+func main() {
+	features.RegisterFeatureGates()
+
+	cmd := app.NewRenderCommand(os.Stdout)
+
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
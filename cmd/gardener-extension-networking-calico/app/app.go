@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 	calicocmd "github.com/gardener/gardener-extension-networking-calico/pkg/cmd"
 	calicocontroller "github.com/gardener/gardener-extension-networking-calico/pkg/controller"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/controller/healthcheck"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
 )
 
@@ -133,6 +134,14 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			log := mgr.GetLogger()
 			log.Info("Adding controllers to manager")
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
+			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyUnderlayMTUs(&calicocontroller.DefaultAddOptions.UnderlayMTUs)
+			configFileOpts.Completed().ApplyDefaults(&calicocontroller.DefaultAddOptions.Defaults)
+			configFileOpts.Completed().ApplyConstraints(&calicocontroller.DefaultAddOptions.Constraints)
+			configFileOpts.Completed().ApplyProfiles(&calicocontroller.DefaultAddOptions.Profiles)
+			configFileOpts.Completed().ApplyDefaults(&healthcheck.DefaultAddOptions.Defaults)
+			configFileOpts.Completed().ApplyProfiles(&healthcheck.DefaultAddOptions.Profiles)
+			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&calicocontroller.DefaultAddOptions.IgnoreOperationAnnotation)
 			calicoCtrlOpts.Completed().Apply(&calicocontroller.DefaultAddOptions.Controller)
 
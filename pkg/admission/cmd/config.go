@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+	configloader "github.com/gardener/gardener-extension-networking-calico/pkg/apis/config/loader"
+)
+
+// ConfigOptions are command line options for the configuration of the admission webhooks.
+type ConfigOptions struct {
+	// ConfigFilePath is the path to the configuration file of the networking extension. It is optional for the
+	// admission webhooks, which only use the network config defaults, constraints and profiles of it.
+	ConfigFilePath string
+
+	config *config.ControllerConfiguration
+}
+
+// Complete implements Completer.Complete.
+func (c *ConfigOptions) Complete() error {
+	if len(c.ConfigFilePath) == 0 {
+		c.config = &config.ControllerConfiguration{}
+		return nil
+	}
+
+	cfg, err := configloader.LoadFromFile(c.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	c.config = cfg
+	return nil
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *ConfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConfigFilePath, "config-file", "", "path to the configuration file of the networking extension with the network config defaults, constraints and profiles")
+}
+
+// ApplyValidatorOptions applies the network config defaults, constraints and profiles to the given validator options.
+// Only call this if `Complete` was successful.
+func (c *ConfigOptions) ApplyValidatorOptions(opts *validator.AddOptions) {
+	opts.Defaults = c.config.Defaults
+	opts.Constraints = c.config.Constraints
+	opts.Profiles = c.config.Profiles
+}
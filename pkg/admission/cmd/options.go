@@ -7,6 +7,7 @@ package cmd
 import (
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 
+	"github.com/gardener/gardener-extension-networking-calico/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/admission/validator"
 )
 
@@ -14,5 +15,6 @@ import (
 func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 	return webhookcmd.NewSwitchOptions(
 		webhookcmd.Switch(validator.Name, validator.New),
+		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
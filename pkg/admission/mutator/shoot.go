@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
+)
+
+// NewShootMutator returns a new instance of a shoot mutator.
+func NewShootMutator() extensionswebhook.Mutator {
+	return &shoot{
+		// The network config is written back into the shoot, hence it must be decoded without defaulting. Otherwise, the
+		// defaults would be persisted and override the defaults and profiles of the operator.
+		decoder: serializer.NewCodecFactory(calicov1alpha1helper.Scheme, serializer.EnableStrict).UniversalDeserializer(),
+	}
+}
+
+type shoot struct {
+	decoder runtime.Decoder
+}
+
+// Mutate mutates the given shoot object. On updates, it moves the deprecated fields of the network config of the shoot
+// into their replacements.
+func (s *shoot) Mutate(_ context.Context, new, old client.Object) error {
+	shoot, ok := new.(*core.Shoot)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", new)
+	}
+
+	// Only migrate on updates, new shoots get the deprecation warnings instead.
+	if old == nil || gardencorehelper.IsWorkerless(shoot) || shoot.Spec.Networking == nil || shoot.Spec.Networking.ProviderConfig == nil {
+		return nil
+	}
+
+	obj, _, err := s.decoder.Decode(shoot.Spec.Networking.ProviderConfig.Raw, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to decode network config: %w", err)
+	}
+	// Only the v1alpha1 API has deprecated fields.
+	networkConfig, ok := obj.(*calicov1alpha1.NetworkConfig)
+	if !ok {
+		return nil
+	}
+
+	ipFamilies := shoot.Spec.Networking.IPFamilies
+	if !calicov1alpha1helper.MigrateDeprecatedFields(networkConfig, len(ipFamilies) == 0 || slices.Contains(ipFamilies, core.IPFamilyIPv4)) {
+		return nil
+	}
+
+	networkConfig.APIVersion = calicov1alpha1.SchemeGroupVersion.String()
+	networkConfig.Kind = "NetworkConfig"
+	raw, err := json.Marshal(networkConfig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal network config: %w", err)
+	}
+	shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{Raw: raw}
+	return nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener-extension-networking-calico/pkg/admission/mutator"
+)
+
+var _ = Describe("Shoot mutator", func() {
+	var (
+		ctx     = context.Background()
+		mutator extensionswebhook.Mutator
+		shoot   *core.Shoot
+	)
+
+	BeforeEach(func() {
+		mutator = NewShootMutator()
+		shoot = &core.Shoot{
+			Spec: core.ShootSpec{
+				Networking: &core.Networking{
+					Type:       ptr.To("calico"),
+					IPFamilies: []core.IPFamily{core.IPFamilyIPv4},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+  "apiVersion": "calico.networking.extensions.gardener.cloud/v1alpha1",
+  "kind": "NetworkConfig",
+  "ipip": "CrossSubnet",
+  "ipAutodetectionMethod": "interface=eth0"
+}`)},
+				},
+				Provider: core.Provider{Workers: []core.Worker{{Name: "worker"}}},
+			},
+		}
+	})
+
+	Describe("#Mutate", func() {
+		It("should not migrate the deprecated fields on creation", func() {
+			providerConfig := shoot.Spec.Networking.ProviderConfig.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, shoot, nil)).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig).To(Equal(providerConfig))
+		})
+
+		It("should move the deprecated fields into the IPv4 section on update", func() {
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig.Raw).To(MatchJSON(`{
+  "apiVersion": "calico.networking.extensions.gardener.cloud/v1alpha1",
+  "kind": "NetworkConfig",
+  "ipv4": {"mode": "CrossSubnet", "autoDetectionMethod": "interface=eth0"}
+}`))
+		})
+
+		It("should drop the deprecated fields on update if the IPv4 section is set", func() {
+			shoot.Spec.Networking.ProviderConfig.Raw = []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipip":"Never","ipv4":{"mode":"Always"}}`)
+
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig.Raw).To(MatchJSON(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipv4":{"mode":"Always"}}`))
+		})
+
+		It("should drop the deprecated fields on update if the shoot does not use IPv4", func() {
+			shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv6}
+
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig.Raw).To(MatchJSON(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`))
+		})
+
+		It("should keep the deprecated fields on update if VXLAN is enabled without IPv4 section", func() {
+			shoot.Spec.Networking.ProviderConfig.Raw = []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipip":"Always","vxlan":{"enabled":true},"ipam":{"type":"host-local"}}`)
+			providerConfig := shoot.Spec.Networking.ProviderConfig.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig).To(Equal(providerConfig))
+		})
+
+		It("should not touch a network config without deprecated fields", func() {
+			shoot.Spec.Networking.ProviderConfig.Raw = []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","backend":"bird"}`)
+			providerConfig := shoot.Spec.Networking.ProviderConfig.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig).To(Equal(providerConfig))
+		})
+
+		It("should not touch a v1alpha2 network config", func() {
+			shoot.Spec.Networking.ProviderConfig.Raw = []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha2","kind":"NetworkConfig","ipv4":{"encapsulation":"VXLAN"}}`)
+			providerConfig := shoot.Spec.Networking.ProviderConfig.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, shoot, shoot.DeepCopy())).To(Succeed())
+			Expect(shoot.Spec.Networking.ProviderConfig).To(Equal(providerConfig))
+		})
+	})
+
+	Describe("#DeprecationWarnings", func() {
+		request := func(raw string) admission.Request {
+			return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Object: runtime.RawExtension{Raw: []byte(raw)}}}
+		}
+
+		It("should warn about the deprecated fields", func() {
+			Expect(DeprecationWarnings(request(`{"spec":{"networking":{"type":"calico","providerConfig":{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipip":"Always","ipAutodetectionMethod":"interface=eth0"}}}}`))).To(ConsistOf(
+				"spec.networking.providerConfig.ipip is deprecated, use spec.networking.providerConfig.ipv4.mode instead (it is moved there automatically when the shoot is updated)",
+				"spec.networking.providerConfig.ipAutodetectionMethod is deprecated, use spec.networking.providerConfig.ipv4.autoDetectionMethod instead (it is moved there automatically when the shoot is updated)",
+			))
+		})
+
+		It("should warn that the deprecated fields are not moved if VXLAN is enabled without IPv4 section", func() {
+			Expect(DeprecationWarnings(request(`{"spec":{"networking":{"type":"calico","providerConfig":{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipip":"Always","vxlan":{"enabled":true}}}}}`))).To(ConsistOf(
+				"spec.networking.providerConfig.ipip is deprecated, use spec.networking.providerConfig.ipv4.mode instead (it is not moved there automatically, because spec.networking.providerConfig.vxlan.enabled switches the IPv4 pool to VXLAN once spec.networking.providerConfig.ipv4 is set)",
+			))
+		})
+
+		It("should not warn without deprecated fields", func() {
+			Expect(DeprecationWarnings(request(`{"spec":{"networking":{"type":"calico","providerConfig":{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipv4":{"mode":"Always"}}}}}`))).To(BeEmpty())
+		})
+
+		It("should not warn for other networking types", func() {
+			Expect(DeprecationWarnings(request(`{"spec":{"networking":{"type":"cilium","providerConfig":{"ipip":"Always"}}}}`))).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+// warningsHandler adds warnings for the deprecated fields in the network config of the shoot to the responses of the
+// wrapped handler.
+type warningsHandler struct {
+	admission.Handler
+}
+
+// Handle implements admission.Handler.
+func (h *warningsHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	return h.Handler.Handle(ctx, req).WithWarnings(DeprecationWarnings(req)...)
+}
+
+// DeprecationWarnings returns warnings for the deprecated fields in the network config of the shoot of the given
+// admission request.
+func DeprecationWarnings(req admission.Request) []string {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := json.Unmarshal(req.Object.Raw, shoot); err != nil {
+		return nil
+	}
+	if shoot.Spec.Networking == nil || !ptr.Equal(shoot.Spec.Networking.Type, ptr.To(calico.ReleaseName)) || shoot.Spec.Networking.ProviderConfig == nil {
+		return nil
+	}
+
+	network := &extensionsv1alpha1.Network{}
+	network.Spec.ProviderConfig = shoot.Spec.Networking.ProviderConfig
+	networkConfig, err := calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
+	if err != nil {
+		return nil
+	}
+	return calicov1alpha1helper.DeprecationWarnings(networkConfig, field.NewPath("spec", "networking", "providerConfig"))
+}
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/admission/validator"
+)
+
+const (
+	// Name is a name for a mutation webhook.
+	Name = "mutator"
+)
+
+var logger = log.Log.WithName("calico-mutator-webhook")
+
+// New creates a new webhook that mutates Shoot resources. Its responses carry warnings for the deprecated fields in the
+// network config of the shoot.
+func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Setting up webhook", "name", Name)
+
+	wh, err := extensionswebhook.New(mgr, extensionswebhook.Args{
+		Name:       Name,
+		Path:       "/webhooks/mutate",
+		Predicates: []predicate.Predicate{validator.CalicoPredicate()},
+		Mutators: map[extensionswebhook.Mutator][]extensionswebhook.Type{
+			NewShootMutator(): {{Obj: &core.Shoot{}}},
+		},
+		Target: extensionswebhook.TargetSeed,
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"networking.extensions.gardener.cloud/calico": "true"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wh.Webhook.Handler = &warningsHandler{Handler: wh.Webhook.Handler}
+	return wh, nil
+}
@@ -21,17 +21,26 @@ import (
 
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
 	calicovalidation "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/controller"
 )
 
 // NewShootValidator returns a new instance of a shoot validator.
-func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
+func NewShootValidator(mgr manager.Manager, opts AddOptions) extensionswebhook.Validator {
+	return newShootValidator(mgr, opts)
+}
+
+func newShootValidator(mgr manager.Manager, opts AddOptions) *shoot {
 	return &shoot{
 		client:         mgr.GetClient(),
 		apiReader:      mgr.GetAPIReader(),
 		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		defaults:       opts.Defaults,
+		constraints:    opts.Constraints,
+		profiles:       opts.Profiles,
 	}
 }
 
@@ -40,6 +49,9 @@ type shoot struct {
 	apiReader      client.Reader
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
+	defaults       map[string]calicov1alpha1.NetworkConfig
+	constraints    map[string]config.NetworkConfigConstraints
+	profiles       map[string]config.NetworkConfigProfile
 }
 
 // Validate validates the given shoot object.
@@ -72,15 +84,25 @@ func (s *shoot) Validate(ctx context.Context, new, old client.Object) error {
 }
 
 func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
+	if errList := calicovalidation.ValidateShootAnnotations(shoot.Annotations, field.NewPath("metadata", "annotations")); len(errList) != 0 {
+		return errList.ToAggregate()
+	}
+
+	providerConfig, err := s.effectiveProviderConfig(shoot)
+	if err != nil {
+		return err
+	}
+
 	// Network validation
 	if shoot.Spec.Networking != nil {
 		if errList := calicovalidation.ValidateNetworking(shoot.Spec.Networking, field.NewPath("spec", "networking")); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
 
-		if shoot.Spec.Networking.ProviderConfig != nil {
+		internalNetworkConfig := &calico.NetworkConfig{}
+		if providerConfig != nil {
 			network := &extensionsv1alpha1.Network{}
-			network.Spec.ProviderConfig = shoot.Spec.Networking.ProviderConfig
+			network.Spec.ProviderConfig = providerConfig
 			networkConfig, err := controller.CalicoNetworkConfigFromNetworkResource(network)
 			if err != nil {
 				return err
@@ -88,7 +110,6 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
 
 			ipFamilies := shoot.Spec.Networking.IPFamilies
 
-			internalNetworkConfig := &calico.NetworkConfig{}
 			err = calicov1alpha1.Convert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(networkConfig, internalNetworkConfig, nil)
 			if err != nil {
 				return err
@@ -97,10 +118,20 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
 			if errList := calicovalidation.ValidateNetworkConfig(internalNetworkConfig, ipFamilies, field.NewPath("spec", "networking", "providerConfig")); len(errList) != 0 {
 				return errList.ToAggregate()
 			}
+
+			if errList := calicovalidation.ValidateNetworkConfigAgainstShoot(internalNetworkConfig, shoot, field.NewPath("spec", "networking", "providerConfig")); len(errList) != 0 {
+				return errList.ToAggregate()
+			}
+		}
+
+		if constraints, ok := s.constraints[shoot.Spec.Provider.Type]; ok {
+			if errList := calicovalidation.ValidateNetworkConfigConstraints(internalNetworkConfig, &constraints, shoot.Spec.Provider.Type, field.NewPath("spec", "networking", "providerConfig")); len(errList) != 0 {
+				return errList.ToAggregate()
+			}
 		}
 	}
 
-	networkConfig, err := s.decodeNetworkingConfig(shoot.Spec.Networking.ProviderConfig)
+	networkConfig, err := s.decodeInternalNetworkingConfig(s.decoder, providerConfig)
 	if err != nil {
 		return err
 	}
@@ -119,17 +150,76 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot) error {
 }
 
 func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.Shoot) error {
-	return s.validateShoot(ctx, shoot)
+	if err := s.validateShoot(ctx, shoot); err != nil {
+		return err
+	}
+
+	_, err := s.validateNetworkConfigUpdate(oldShoot, shoot)
+	return err
+}
+
+// validateNetworkConfigUpdate validates the changes of the network config of the given updated shoot. It returns the
+// warnings for the accepted changes which are disruptive.
+func (s *shoot) validateNetworkConfigUpdate(oldShoot, shoot *core.Shoot) ([]string, error) {
+	if oldShoot.Spec.Networking == nil || shoot.Spec.Networking == nil {
+		return nil, nil
+	}
+
+	// Compare the effective network configs, as a change of the referenced profile or of the defaults changes the
+	// network config as well.
+	oldProviderConfig, err := s.effectiveProviderConfig(oldShoot)
+	if err != nil {
+		// The profile referenced by the old shoot may have been removed in the meantime.
+		oldProviderConfig = oldShoot.Spec.Networking.ProviderConfig
+	}
+	providerConfig, err := s.effectiveProviderConfig(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	oldNetworkConfig, err := s.decodeInternalNetworkingConfig(s.lenientDecoder, oldProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+	networkConfig, err := s.decodeInternalNetworkingConfig(s.decoder, providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fldPath := field.NewPath("spec", "networking", "providerConfig")
+	errList := calicovalidation.ValidateNetworkConfigUpdate(oldNetworkConfig, networkConfig, oldShoot.Spec.Networking.IPFamilies, fldPath)
+	transitionErrList, warnings := calicovalidation.ValidateNetworkConfigTransitions(oldNetworkConfig, networkConfig, oldShoot, shoot, fldPath)
+	errList = append(errList, transitionErrList...)
+	if len(errList) != 0 {
+		return nil, errList.ToAggregate()
+	}
+
+	return warnings, nil
 }
 
 func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot) error {
 	return s.validateShoot(ctx, shoot)
 }
 
-func (s *shoot) decodeNetworkingConfig(network *runtime.RawExtension) (*calicov1alpha1.NetworkConfig, error) {
-	networkConfig := &calicov1alpha1.NetworkConfig{}
+// effectiveProviderConfig returns the network provider config of the given shoot with the network config profile it
+// references and the network config defaults of the operator for the provider of the shoot merged into it.
+func (s *shoot) effectiveProviderConfig(shoot *core.Shoot) (*runtime.RawExtension, error) {
+	var providerConfig *runtime.RawExtension
+	if shoot.Spec.Networking != nil {
+		providerConfig = shoot.Spec.Networking.ProviderConfig
+	}
+
+	var defaults *calicov1alpha1.NetworkConfig
+	if networkConfig, ok := s.defaults[shoot.Spec.Provider.Type]; ok {
+		defaults = &networkConfig
+	}
+	return calicov1alpha1helper.ResolveNetworkConfig(providerConfig, defaults, s.profiles, field.NewPath("spec", "networking", "providerConfig"))
+}
+
+func (s *shoot) decodeInternalNetworkingConfig(decoder runtime.Decoder, network *runtime.RawExtension) (*calico.NetworkConfig, error) {
+	networkConfig := &calico.NetworkConfig{}
 	if network != nil && network.Raw != nil {
-		if _, _, err := s.decoder.Decode(network.Raw, nil, networkConfig); err != nil {
+		if _, _, err := decoder.Decode(network.Raw, nil, networkConfig); err != nil {
 			return nil, err
 		}
 	}
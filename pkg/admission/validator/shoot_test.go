@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+
+	calicoinstall "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/install"
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+var _ = Describe("Shoot", func() {
+	Describe("#validateNetworkConfigUpdate", func() {
+		var (
+			s                  *shoot
+			oldShoot, newShoot *core.Shoot
+		)
+
+		newShootWithProviderConfig := func(providerConfig string) *core.Shoot {
+			return &core.Shoot{Spec: core.ShootSpec{
+				Provider: core.Provider{Type: "aws"},
+				Networking: &core.Networking{
+					Type:           ptr.To(calico.Type),
+					IPFamilies:     []core.IPFamily{core.IPFamilyIPv4},
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(providerConfig)},
+				},
+			}}
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(calicoinstall.AddToScheme(scheme)).To(Succeed())
+			s = &shoot{
+				decoder:        serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+				lenientDecoder: serializer.NewCodecFactory(scheme).UniversalDecoder(),
+				profiles: map[string]config.NetworkConfigProfile{
+					"small-blocks": {NetworkConfig: calicov1alpha1.NetworkConfig{IPv4: &calicov1alpha1.IPv4{BlockSize: ptr.To[int32](28)}}},
+				},
+			}
+
+			oldShoot = newShootWithProviderConfig(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","profile":"small-blocks"}`)
+		})
+
+		It("should allow updates which keep the effective network config", func() {
+			newShoot = newShootWithProviderConfig(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","profile":"small-blocks","ipv4":{"blockSize":28}}`)
+
+			Expect(s.validateNetworkConfigUpdate(oldShoot, newShoot)).To(BeEmpty())
+		})
+
+		It("should forbid changes of the effective network config by dropping the profile", func() {
+			newShoot = newShootWithProviderConfig(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`)
+
+			_, err := s.validateNetworkConfigUpdate(oldShoot, newShoot)
+			Expect(err).To(MatchError(ContainSubstring("spec.networking.providerConfig.ipv4.blockSize")))
+		})
+
+		It("should fall back to the provider config of the old shoot if its profile has been removed", func() {
+			delete(s.profiles, "small-blocks")
+			newShoot = newShootWithProviderConfig(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`)
+
+			Expect(s.validateNetworkConfigUpdate(oldShoot, newShoot)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+
+	gardencorehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	"github.com/gardener/gardener/pkg/apis/core"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+// warningsHandler adds warnings for the disruptive changes of the network config of the shoot to the responses of the
+// wrapped handler.
+type warningsHandler struct {
+	admission.Handler
+	validator *shoot
+}
+
+// Handle implements admission.Handler.
+func (h *warningsHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	response := h.Handler.Handle(ctx, req)
+	if !response.Allowed || req.Operation != admissionv1.Update {
+		return response
+	}
+	return response.WithWarnings(h.transitionWarnings(req)...)
+}
+
+// transitionWarnings returns warnings for the disruptive changes of the network config of the shoot of the given
+// admission request.
+func (h *warningsHandler) transitionWarnings(req admission.Request) []string {
+	oldShoot, shoot := &core.Shoot{}, &core.Shoot{}
+	if _, _, err := h.validator.lenientDecoder.Decode(req.OldObject.Raw, nil, oldShoot); err != nil {
+		return nil
+	}
+	if _, _, err := h.validator.lenientDecoder.Decode(req.Object.Raw, nil, shoot); err != nil {
+		return nil
+	}
+	if gardencorehelper.IsWorkerless(shoot) || shoot.Spec.Networking == nil || !ptr.Equal(shoot.Spec.Networking.Type, ptr.To(calico.ReleaseName)) {
+		return nil
+	}
+
+	warnings, err := h.validator.validateNetworkConfigUpdate(oldShoot, shoot)
+	if err != nil {
+		return nil
+	}
+	return warnings
+}
@@ -14,6 +14,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
 
@@ -22,24 +24,47 @@ const (
 	Name = "validator"
 )
 
-var logger = log.Log.WithName("calico-validator-webhook")
+var (
+	logger = log.Log.WithName("calico-validator-webhook")
 
-// New creates a new webhook that validates Shoot resources.
+	// DefaultAddOptions are the default AddOptions for New.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when creating the validator webhook.
+type AddOptions struct {
+	// Defaults maps provider types to the defaults of the network configs of the shoots of the provider.
+	Defaults map[string]calicov1alpha1.NetworkConfig
+	// Constraints maps provider types to the constraints of the network configs of the shoots of the provider.
+	Constraints map[string]config.NetworkConfigConstraints
+	// Profiles maps names to the network config profiles which shoots can reference.
+	Profiles map[string]config.NetworkConfigProfile
+}
+
+// New creates a new webhook that validates Shoot resources. Its responses carry warnings for the disruptive changes of
+// the network config of the shoot.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
 
-	return extensionswebhook.New(mgr, extensionswebhook.Args{
+	validator := newShootValidator(mgr, DefaultAddOptions)
+	wh, err := extensionswebhook.New(mgr, extensionswebhook.Args{
 		Name:       Name,
 		Path:       "/webhooks/validate",
 		Predicates: []predicate.Predicate{CalicoPredicate()},
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(mgr): {{Obj: &core.Shoot{}}},
+			validator: {{Obj: &core.Shoot{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
 			MatchLabels: map[string]string{"networking.extensions.gardener.cloud/calico": "true"},
 		},
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	wh.Webhook.Handler = &warningsHandler{Handler: wh.Webhook.Handler, validator: validator}
+	return wh, nil
 }
 
 // CalicoPredicate returns a predicate that checks the calico networking type in the shoot spec.
@@ -10,11 +10,13 @@ import (
 
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha2"
 )
 
 var (
 	schemeBuilder = runtime.NewSchemeBuilder(
 		v1alpha1.AddToScheme,
+		v1alpha2.AddToScheme,
 		calico.AddToScheme,
 		setVersionPriority,
 	)
@@ -24,7 +26,7 @@ var (
 )
 
 func setVersionPriority(scheme *runtime.Scheme) error {
-	return scheme.SetVersionPriority(v1alpha1.SchemeGroupVersion)
+	return scheme.SetVersionPriority(v1alpha1.SchemeGroupVersion, v1alpha2.SchemeGroupVersion)
 }
 
 // Install installs all APIs in the scheme.
@@ -54,6 +54,9 @@ type IPv4 struct {
 	// AutoDetectionMethod is the method to use to autodetect the IPv4 address for this host. This is only used when the IPv4 address is being autodetected.
 	// https://docs.projectcalico.org/v3.8/reference/node/configuration#ip-autodetection-methods
 	AutoDetectionMethod *string
+	// BlockSize is the prefix length of the address blocks of the default IPv4 pool allocated to the nodes (default: 26).
+	// It is only used by the calico-ipam.
+	BlockSize *int32
 }
 
 // IPv6 contains configuration for calico ipv6 specific settings
@@ -62,7 +65,6 @@ type IPv6 struct {
 	// https://docs.tigera.io/calico/latest/reference/configure-calico-node#configuring-the-default-ip-pools
 	Pool *Pool
 	// Mode is the mode for the IPv6 Pool (e.g. Always, Never, CrossSubnet)
-	// vxlan pools accept only Always and Never (unchecked)
 	Mode *PoolMode
 	// AutoDetectionMethod is the method to use to autodetect the IPv6 address for this host. This is only used when the IPv6 address is being autodetected.
 	// https://docs.projectcalico.org/v3.8/reference/node/configuration#ip-autodetection-methods
@@ -70,6 +72,9 @@ type IPv6 struct {
 	// SourceNATEnabled indicates whether the pod IP addresses should be masqueraded when targeting external destinations.
 	// Per default, source network address translation is disabled.
 	SourceNATEnabled *bool
+	// BlockSize is the prefix length of the address blocks of the default IPv6 pool allocated to the nodes (default: 122).
+	// It is only used by the calico-ipam.
+	BlockSize *int32
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -77,6 +82,9 @@ type IPv6 struct {
 // NetworkConfig configuration for the calico networking plugin
 type NetworkConfig struct {
 	metav1.TypeMeta
+	// Profile is the name of a network config profile of the operator whose settings are applied unless this network
+	// config sets them.
+	Profile *string
 	// Backend defines whether a backend should be used or not (e.g., bird or none)
 	Backend *Backend
 	// IPAM to use for the Calico Plugin (e.g., host-local or calico-ipam)
@@ -115,6 +123,8 @@ type NetworkConfig struct {
 
 	// WireguardEncryption is the option to enable node to node wireguard encryption
 	WireguardEncryption bool
+	// Wireguard contains further settings of the WireGuard encryption.
+	Wireguard *Wireguard
 
 	// BirdExporter configures the bird metrics exporter.
 	BirdExporter *BirdExporter
@@ -124,6 +134,22 @@ type NetworkConfig struct {
 
 	// ServiceLoopPrevention configures the Felix service loop prevention option.
 	ServiceLoopPrevention *ServiceLoopPrevention
+
+	// BGP configures the BGP routing of calico-node, e.g. peering with external routers or route reflectors.
+	// It is only supported in conjunction with the bird backend.
+	BGP *BGP
+
+	// IPPools is a list of additional calico IP pools, e.g. to assign different address ranges to worker pools or namespaces.
+	// Additional IP pools require the calico-ipam.
+	IPPools []IPPool
+
+	// Felix contains settings of felix, which are passed to calico-node as environment variables.
+	// Only an allowlisted set of settings is supported.
+	Felix *FelixConfiguration
+
+	// WorkerPools contains settings of calico-node which differ for the nodes of individual worker pools.
+	// calico-node is deployed as a separate DaemonSet for each of the worker pools.
+	WorkerPools []WorkerPool
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -131,6 +157,126 @@ type NetworkConfig struct {
 // NetworkStatus contains information about created Network resources.
 type NetworkStatus struct {
 	metav1.TypeMeta
+
+	// Backend is the effective backend of calico-node (e.g. bird, vxlan or none).
+	Backend *Backend
+	// IPAM is the effective IPAM plugin (e.g. host-local or calico-ipam).
+	IPAM *string
+	// Dataplane is the effective dataplane of felix (e.g. iptables, nftables or ebpf).
+	Dataplane *Dataplane
+	// OverlayEnabled indicates whether the pod traffic between nodes is encapsulated.
+	OverlayEnabled bool
+	// VXLANEnabled indicates whether vxlan is used to encapsulate the pod traffic between nodes.
+	VXLANEnabled bool
+	// IPv4 contains the effective settings of the IPv4 pool, if the shoot uses IPv4.
+	IPv4 *IPFamilyStatus
+	// IPv6 contains the effective settings of the IPv6 pool, if the shoot uses IPv6.
+	IPv6 *IPFamilyStatus
+	// VethMTU is the MTU of the pod interfaces if it is set explicitly or derived from the MTU of the node network.
+	VethMTU *string
+	// Wireguard contains the progress of the WireGuard encryption on the nodes, if it is enabled.
+	Wireguard *WireguardStatus
+	// Profile is the network config profile of the operator which is applied, if any.
+	Profile *ProfileStatus
+	// Migration contains the progress of an ongoing migration of the pod network, if any.
+	Migration *MigrationStatus
+}
+
+// WireguardStatus contains the progress of the WireGuard encryption on the nodes.
+type WireguardStatus struct {
+	// EncryptedNodes is the number of nodes which have a WireGuard public key for all IP families of the shoot.
+	EncryptedNodes int32
+	// PendingNodes is the number of nodes which do not have a WireGuard public key for all IP families of the shoot yet.
+	PendingNodes int32
+	// UnsupportedNodes are the names of the nodes whose kernel does not support WireGuard.
+	UnsupportedNodes []string
+	// KeyRotation is the identifier of the last completed rotation of the WireGuard keys.
+	KeyRotation *string
+}
+
+// ProfileStatus contains the network config profile of the operator which is applied.
+type ProfileStatus struct {
+	// Name is the name of the profile.
+	Name string
+	// Version is the version of the profile.
+	Version string
+}
+
+// MigrationStatus contains the progress of a migration of the pod network.
+type MigrationStatus struct {
+	// Type is the type of the migration.
+	Type MigrationType
+	// Phase is the current phase of the migration.
+	Phase MigrationPhase
+	// Nodes contains the progress of the migration per node.
+	Nodes []NodeMigrationStatus
+}
+
+// MigrationType is the type of a migration of the pod network.
+type MigrationType string
+
+const (
+	// MigrationTypeOverlayEnablement is the migration from the non-overlay to the overlay network.
+	MigrationTypeOverlayEnablement MigrationType = "OverlayEnablement"
+	// MigrationTypeIPIPToVXLAN is the migration of the encapsulation of the default IPv4 pool from IPIP to VXLAN.
+	MigrationTypeIPIPToVXLAN MigrationType = "IPIPToVXLAN"
+	// MigrationTypeHostLocalToCalicoIPAM is the migration of the IPAM of the pod network from host-local to calico-ipam.
+	MigrationTypeHostLocalToCalicoIPAM MigrationType = "HostLocalToCalicoIPAM"
+	// MigrationTypeIPTablesToEBPF is the migration of the dataplane from iptables to eBPF.
+	MigrationTypeIPTablesToEBPF MigrationType = "IPTablesToEBPF"
+	// MigrationTypeEBPFToIPTables is the migration of the dataplane from eBPF to iptables.
+	MigrationTypeEBPFToIPTables MigrationType = "EBPFToIPTables"
+	// MigrationTypeIPv4ToDualStack is the migration of the pod network from IPv4 to dual-stack.
+	MigrationTypeIPv4ToDualStack MigrationType = "IPv4ToDualStack"
+	// MigrationTypeWireguardKeyRotation is the rotation of the WireGuard keys of the nodes.
+	MigrationTypeWireguardKeyRotation MigrationType = "WireguardKeyRotation"
+)
+
+// MigrationPhase is the phase of a migration of the pod network.
+type MigrationPhase string
+
+const (
+	// MigrationPhasePreparing is the phase in which the nodes are prepared for the migration, while the pod
+	// traffic is still handled the previous way.
+	MigrationPhasePreparing MigrationPhase = "Preparing"
+	// MigrationPhaseMigrating is the phase in which the nodes are switched to the desired configuration one by one.
+	MigrationPhaseMigrating MigrationPhase = "Migrating"
+)
+
+// NodeMigrationStatus contains the progress of a migration of the pod network on a node.
+type NodeMigrationStatus struct {
+	// Name is the name of the node.
+	Name string
+	// Ready indicates whether the node is ready for the next phase of the migration.
+	Ready bool
+	// Message describes why the node is not ready yet.
+	Message *string
+	// PreviousWireguardPublicKeys are the WireGuard public keys of the node before the rotation of the WireGuard keys.
+	PreviousWireguardPublicKeys []string
+}
+
+// Dataplane is the dataplane used by felix.
+type Dataplane string
+
+const (
+	// DataplaneIPTables is the iptables dataplane.
+	DataplaneIPTables Dataplane = "iptables"
+	// DataplaneNFTables is the nftables dataplane.
+	DataplaneNFTables Dataplane = "nftables"
+	// DataplaneEBPF is the eBPF dataplane.
+	DataplaneEBPF Dataplane = "ebpf"
+)
+
+// IPFamilyStatus contains the effective settings of the default IP pool of an IP family.
+type IPFamilyStatus struct {
+	// Pool is the effective type of the ip pool for the tunnel interface (e.g. ipip or vxlan).
+	Pool Pool
+	// Mode is the effective mode of the pool (e.g. Always, Never, CrossSubnet).
+	Mode PoolMode
+	// AutoDetectionMethod is the effective method to autodetect the address of the nodes.
+	AutoDetectionMethod *string
+	// BlockSize is the effective prefix length of the address blocks allocated to the nodes.
+	BlockSize int32
 }
 
 // IPAM defines the block that configuration for the ip assignment plugin to be used
@@ -139,6 +285,18 @@ type IPAM struct {
 	Type string
 	// CIDR defines the CIDR block to be used
 	CIDR *CIDR
+	// Config configures the calico-ipam. It is rendered as the IPAMConfig resource of calico.
+	Config *IPAMConfig
+}
+
+// IPAMConfig contains the configuration of the calico-ipam.
+type IPAMConfig struct {
+	// StrictAffinity forbids the nodes to borrow addresses from the blocks of other nodes (default: false).
+	StrictAffinity *bool
+	// MaxBlocksPerHost is the maximum number of blocks a node may allocate (default: 0, i.e. unlimited).
+	MaxBlocksPerHost *int32
+	// AutoAllocateBlocks allows the nodes to allocate new blocks on demand (default: true).
+	AutoAllocateBlocks *bool
 }
 
 // Typha defines the block with configurations for calico typha
@@ -211,6 +369,25 @@ type VXLAN struct {
 	Enabled bool
 }
 
+// Wireguard contains further settings of the WireGuard encryption.
+// The routing table of the WireGuard routes is not configurable, as felix allocates it from its route table ranges.
+type Wireguard struct {
+	// HostEncryptionEnabled enables the encryption of the host network traffic between nodes in addition to the pod
+	// traffic.
+	HostEncryptionEnabled *bool
+	// RoutingRulePriority is the priority of the routing rule which directs the encrypted traffic to the WireGuard
+	// routing table (default: 99).
+	RoutingRulePriority *int32
+	// InterfaceName is the name of the IPv4 WireGuard interface (default: wireguard.cali).
+	InterfaceName *string
+	// InterfaceNameV6 is the name of the IPv6 WireGuard interface (default: wg-v6.cali).
+	InterfaceNameV6 *string
+	// ListeningPort is the listening port of the IPv4 WireGuard interface (default: 51820).
+	ListeningPort *int32
+	// ListeningPortV6 is the listening port of the IPv6 WireGuard interface (default: 51821).
+	ListeningPortV6 *int32
+}
+
 type BirdExporter struct {
 	// Enabled enables the bird metrics exporter.
 	Enabled bool
@@ -230,3 +407,160 @@ const (
 	ServiceLoopPreventionDrop     ServiceLoopPrevention = "Drop"
 	ServiceLoopPreventionReject   ServiceLoopPrevention = "Reject"
 )
+
+// BGP contains configuration for the BGP routing of calico-node.
+type BGP struct {
+	// ASNumber is the default AS number used by the nodes of the cluster (default: 64512).
+	ASNumber *uint32
+	// NodeToNodeMeshEnabled enables the full node-to-node BGP mesh.
+	// Defaults to true unless route reflectors are configured.
+	NodeToNodeMeshEnabled *bool
+	// Peers is a list of BGP peers of the cluster nodes, e.g. top-of-rack routers.
+	Peers []BGPPeer
+	// RouteReflector configures a set of cluster nodes to act as route reflectors for all other nodes.
+	RouteReflector *BGPRouteReflector
+	// ServiceClusterIPs configures the advertisement of service cluster IPs.
+	ServiceClusterIPs *BGPServiceClusterIPs
+	// ServiceExternalIPs is a list of CIDRs of service external IPs to advertise.
+	ServiceExternalIPs []CIDR
+	// ServiceLoadBalancerIPs is a list of CIDRs of service load balancer IPs to advertise.
+	ServiceLoadBalancerIPs []CIDR
+}
+
+// BGPPeer describes a BGP peer of the cluster nodes.
+type BGPPeer struct {
+	// Name is the name of the BGPPeer resource.
+	Name string
+	// PeerIP is the IP address of an external BGP peer. Either PeerIP or PeerSelector must be set.
+	PeerIP *string
+	// ASNumber is the AS number of the external BGP peer. It is required if PeerIP is set.
+	ASNumber *uint32
+	// NodeSelector is a calico selector for the nodes that should have this peering (default: all nodes).
+	NodeSelector *string
+	// PeerSelector is a calico selector for the cluster nodes that act as BGP peers.
+	// Either PeerIP or PeerSelector must be set.
+	PeerSelector *string
+}
+
+// BGPRouteReflector configures cluster nodes as BGP route reflectors.
+type BGPRouteReflector struct {
+	// NodeSelector is a calico selector for the nodes acting as route reflectors, e.g. `route-reflector == 'true'`.
+	// The selected nodes need to carry the `projectcalico.org/RouteReflectorClusterID` annotation.
+	NodeSelector string
+}
+
+// BGPServiceClusterIPs configures the advertisement of service cluster IPs.
+type BGPServiceClusterIPs struct {
+	// CIDRs is a list of CIDRs of service cluster IPs to advertise (default: the service CIDRs of the shoot).
+	// The CIDRs must be within the service CIDRs of the shoot.
+	CIDRs []CIDR
+}
+
+// IPPoolAllowedUse is a use of the addresses of an IP pool.
+type IPPoolAllowedUse string
+
+const (
+	// IPPoolAllowedUseWorkload allows to assign addresses of the pool to pods.
+	IPPoolAllowedUseWorkload IPPoolAllowedUse = "Workload"
+	// IPPoolAllowedUseTunnel allows to assign addresses of the pool to the tunnel devices of the nodes.
+	IPPoolAllowedUseTunnel IPPoolAllowedUse = "Tunnel"
+	// IPPoolAllowedUseLoadBalancer allows to assign addresses of the pool to services of type LoadBalancer.
+	IPPoolAllowedUseLoadBalancer IPPoolAllowedUse = "LoadBalancer"
+)
+
+// IPPool describes an additional calico IP pool.
+type IPPool struct {
+	// Name is the name of the IPPool resource.
+	Name string
+	// CIDR is the address range of the pool. It must not overlap with the node, pod or service CIDRs of the shoot or other IP pools.
+	CIDR CIDR
+	// BlockSize is the prefix length of the address blocks allocated to the nodes.
+	// Defaults to the block size of the default IP pool of the same IP family.
+	BlockSize *int32
+	// Encapsulation is the encapsulation used for traffic of the pool (e.g. ipip or vxlan).
+	// Defaults to the encapsulation of the default IP pool of the same IP family.
+	Encapsulation *Pool
+	// Mode is the encapsulation mode of the pool (e.g. Always, Never, CrossSubnet).
+	// Defaults to the mode of the default IP pool of the same IP family.
+	Mode *PoolMode
+	// NATOutgoing enables the masquerading of traffic from the pool to external destinations.
+	// Defaults to the setting of the default IP pool of the same IP family.
+	NATOutgoing *bool
+	// NodeSelector is a calico selector for the nodes that allocate addresses from the pool (default: all nodes).
+	NodeSelector *string
+	// NamespaceSelector is a calico selector for the namespaces whose pods get addresses from the pool (default: all namespaces).
+	NamespaceSelector *string
+	// AllowedUses are the uses of the addresses of the pool (default: Workload and Tunnel).
+	AllowedUses []IPPoolAllowedUse
+}
+
+// FelixConfiguration contains the allowlisted settings of felix. The names of the fields match the ones of the
+// FelixConfiguration resource of calico.
+type FelixConfiguration struct {
+	// LogSeverityScreen is the minimum severity of the logs written by felix (Debug, Info, Warning, Error or Fatal).
+	LogSeverityScreen *string
+	// RouteRefreshInterval is the period at which felix re-checks the routes in the dataplane.
+	RouteRefreshInterval *metav1.Duration
+	// IptablesRefreshInterval is the period at which felix re-checks the iptables rules in the dataplane.
+	IptablesRefreshInterval *metav1.Duration
+	// NftablesRefreshInterval is the period at which felix re-checks the nftables rules in the dataplane.
+	NftablesRefreshInterval *metav1.Duration
+	// BPFConntrackTimeouts are the timeouts of the connection tracking of the eBPF dataplane.
+	BPFConntrackTimeouts *FelixConntrackTimeouts
+	// FailsafeInboundHostPorts are the ports on which incoming traffic to the nodes is always allowed, irrespective of
+	// the network policies.
+	FailsafeInboundHostPorts []FelixProtoPort
+	// FailsafeOutboundHostPorts are the ports to which outgoing traffic from the nodes is always allowed, irrespective of
+	// the network policies.
+	FailsafeOutboundHostPorts []FelixProtoPort
+	// NATPortRange is the range of source ports used for masquerading, e.g. 32768:65535 (default).
+	NATPortRange *string
+	// FlowLogsFlushInterval is the period at which felix exports the flow logs.
+	FlowLogsFlushInterval *metav1.Duration
+	// FlowLogsPolicyEvaluationMode defines how felix evaluates the policies of active flows (None or Continuous).
+	FlowLogsPolicyEvaluationMode *string
+}
+
+// FelixConntrackTimeouts contains the timeouts of the connection tracking of the eBPF dataplane.
+type FelixConntrackTimeouts struct {
+	// CreationGracePeriod is the time after which incomplete connections are removed.
+	CreationGracePeriod *metav1.Duration
+	// TCPSynSent is the timeout of TCP connections for which only a SYN has been seen.
+	TCPSynSent *metav1.Duration
+	// TCPEstablished is the timeout of established TCP connections.
+	TCPEstablished *metav1.Duration
+	// TCPFinsSeen is the timeout of TCP connections for which FINs have been seen in both directions.
+	TCPFinsSeen *metav1.Duration
+	// TCPResetSeen is the timeout of TCP connections for which a RST has been seen.
+	TCPResetSeen *metav1.Duration
+	// UDPTimeout is the timeout of UDP connections.
+	UDPTimeout *metav1.Duration
+	// GenericTimeout is the timeout of connections of other protocols.
+	GenericTimeout *metav1.Duration
+	// ICMPTimeout is the timeout of ICMP connections.
+	ICMPTimeout *metav1.Duration
+}
+
+// FelixProtoPort is a combination of protocol, port and CIDR.
+type FelixProtoPort struct {
+	// Protocol is the protocol of the traffic (tcp, udp or sctp, default: tcp).
+	Protocol *string
+	// Port is the port of the traffic.
+	Port int32
+	// Net is the CIDR of the remote addresses of the traffic (default: all addresses).
+	Net *string
+}
+
+// WorkerPool contains settings of calico-node which differ for the nodes of a worker pool.
+type WorkerPool struct {
+	// Name is the name of the worker pool in the shoot.
+	Name string
+	// EbpfDataplane overrides whether the eBPF dataplane is enabled on the nodes of the worker pool.
+	EbpfDataplane *EbpfDataplane
+	// IPv4AutoDetectionMethod overrides the method to autodetect the IPv4 address of the nodes of the worker pool.
+	IPv4AutoDetectionMethod *string
+	// IPv6AutoDetectionMethod overrides the method to autodetect the IPv6 address of the nodes of the worker pool.
+	IPv6AutoDetectionMethod *string
+	// VethMTU overrides the MTU of the pod interfaces and tunnel devices on the nodes of the worker pool.
+	VethMTU *string
+}
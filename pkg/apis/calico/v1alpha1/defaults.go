@@ -6,8 +6,72 @@ package v1alpha1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_NetworkConfig sets the defaults of the network config. The backend, the IPAM type and the IPv4 pool mode
+// are only defaulted as far as they do not depend on the IP families of the shoot, otherwise they are derived during
+// the reconciliation. The IPv6 pool mode is not defaulted, as only an explicit mode encapsulates the IPv6 pod traffic.
+func SetDefaults_NetworkConfig(obj *NetworkConfig) {
+	vxlanEnabled := obj.VXLAN != nil && obj.VXLAN.Enabled
+
+	// With overlay settings, the backend is derived from them.
+	if obj.Backend == nil && obj.Overlay == nil {
+		obj.Backend = ptr.To(Bird)
+	}
+
+	if obj.IPAM == nil {
+		obj.IPAM = &IPAM{}
+	}
+	// VXLAN implies calico-ipam for the IPv4 pod addresses only, hence the IPAM type is derived from the IP families then.
+	if obj.IPAM.Type == "" && !vxlanEnabled {
+		obj.IPAM.Type = IPAMHostLocal
+	}
+
+	if obj.IPv4 != nil {
+		if obj.IPv4.Pool == nil {
+			obj.IPv4.Pool = ptr.To(PoolIPIP)
+			if vxlanEnabled {
+				obj.IPv4.Pool = ptr.To(PoolVXLan)
+			}
+		}
+		if obj.IPv4.Mode == nil && obj.Overlay != nil {
+			obj.IPv4.Mode = ptr.To(overlayPoolMode(obj.Overlay))
+		}
+	}
+
+	if obj.Typha == nil {
+		obj.Typha = &Typha{Enabled: true}
+	}
+
+	// Source NAT to the upstream DNS servers is only relevant without overlay.
+	if obj.SnatToUpstreamDNS == nil && obj.Overlay != nil && !obj.Overlay.Enabled {
+		obj.SnatToUpstreamDNS = &SnatToUpstreamDNS{Enabled: true}
+	}
+}
+
+// SetDefaults_IPv6 sets the defaults of the IPv6 settings.
+func SetDefaults_IPv6(obj *IPv6) {
+	if obj.Pool == nil {
+		obj.Pool = ptr.To(PoolVXLan)
+	}
+}
+
+// SetDefaults_AutoScaling sets the defaults of the autoscaling settings.
+func SetDefaults_AutoScaling(obj *AutoScaling) {
+	if obj.Mode == "" {
+		obj.Mode = AutoscalingModeClusterProportional
+	}
+}
+
+// overlayPoolMode returns the mode of the default IP pools for the given overlay settings.
+func overlayPoolMode(overlay *Overlay) PoolMode {
+	if overlay.Enabled {
+		return Always
+	}
+	return Never
+}
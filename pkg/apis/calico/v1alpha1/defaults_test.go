@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_NetworkConfig", func() {
+		It("should default an empty network config", func() {
+			obj := &NetworkConfig{}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj).To(Equal(&NetworkConfig{
+				Backend: ptr.To(Bird),
+				IPAM:    &IPAM{Type: IPAMHostLocal},
+				Typha:   &Typha{Enabled: true},
+			}))
+		})
+
+		It("should not overwrite explicit settings", func() {
+			obj := &NetworkConfig{
+				Backend:           ptr.To(None),
+				IPAM:              &IPAM{Type: IPAMCalico},
+				IPv4:              &IPv4{Pool: ptr.To(PoolVXLan), Mode: ptr.To(CrossSubnet)},
+				IPv6:              &IPv6{Pool: ptr.To(PoolVXLan), Mode: ptr.To(Never)},
+				Typha:             &Typha{Enabled: false},
+				SnatToUpstreamDNS: &SnatToUpstreamDNS{Enabled: false},
+				AutoScaling:       &AutoScaling{Mode: AutoscalingModeVPA},
+				Overlay:           &Overlay{Enabled: false},
+			}
+			expected := obj.DeepCopy()
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj).To(Equal(expected))
+		})
+
+		It("should not default the backend and the pool modes without overlay settings", func() {
+			obj := &NetworkConfig{IPv4: &IPv4{}, IPv6: &IPv6{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.IPv4).To(Equal(&IPv4{Pool: ptr.To(PoolIPIP)}))
+			Expect(obj.IPv6).To(Equal(&IPv6{Pool: ptr.To(PoolVXLan)}))
+		})
+
+		It("should default the IPv4 pool mode but not the backend and the IPv6 pool mode with overlay settings", func() {
+			obj := &NetworkConfig{Overlay: &Overlay{Enabled: true}, IPv4: &IPv4{}, IPv6: &IPv6{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.Backend).To(BeNil())
+			Expect(obj.IPv4.Mode).To(Equal(ptr.To(Always)))
+			Expect(obj.IPv6.Mode).To(BeNil())
+			Expect(obj.SnatToUpstreamDNS).To(BeNil())
+		})
+
+		It("should enable source NAT to the upstream DNS servers without overlay", func() {
+			obj := &NetworkConfig{Overlay: &Overlay{Enabled: false}, IPv4: &IPv4{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.IPv4.Mode).To(Equal(ptr.To(Never)))
+			Expect(obj.SnatToUpstreamDNS).To(Equal(&SnatToUpstreamDNS{Enabled: true}))
+		})
+
+		It("should default the IPv4 pool but not the IPAM type for VXLAN", func() {
+			obj := &NetworkConfig{VXLAN: &VXLAN{Enabled: true}, IPv4: &IPv4{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.IPAM).To(Equal(&IPAM{}))
+			Expect(obj.IPv4.Pool).To(Equal(ptr.To(PoolVXLan)))
+		})
+
+		It("should default the autoscaling mode", func() {
+			obj := &NetworkConfig{AutoScaling: &AutoScaling{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.AutoScaling.Mode).To(Equal(AutoscalingModeClusterProportional))
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/install"
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 )
@@ -31,11 +32,16 @@ func init() {
 }
 
 // CalicoNetworkConfigFromNetworkResource extracts the NetworkConfig from the
-// ProviderConfig section of the given Network resource.
+// ProviderConfig section of the given Network resource. The ProviderConfig may be
+// of any supported version, it is converted to v1alpha1.
 func CalicoNetworkConfigFromNetworkResource(network *extensionsv1alpha1.Network) (*calicov1alpha1.NetworkConfig, error) {
-	config := &calicov1alpha1.NetworkConfig{}
 	if network.Spec.ProviderConfig != nil && network.Spec.ProviderConfig.Raw != nil {
-		if _, _, err := decoder.Decode(network.Spec.ProviderConfig.Raw, nil, config); err != nil {
+		internalConfig := &calico.NetworkConfig{}
+		if _, _, err := decoder.Decode(network.Spec.ProviderConfig.Raw, nil, internalConfig); err != nil {
+			return nil, err
+		}
+		config := &calicov1alpha1.NetworkConfig{}
+		if err := Scheme.Convert(internalConfig, config, nil); err != nil {
 			return nil, err
 		}
 		return config, nil
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha2 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha2"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+)
+
+// ResolveNetworkConfig returns the effective provider config of a shoot, which layers the given provider config over
+// the network config profile it references, if any, and both over the given defaults of the operator. The profile may
+// also be referenced by the defaults. It returns an error if the referenced profile does not exist.
+func ResolveNetworkConfig(providerConfig *runtime.RawExtension, defaults *calicov1alpha1.NetworkConfig, profiles map[string]config.NetworkConfigProfile, fldPath *field.Path) (*runtime.RawExtension, error) {
+	merged, err := MergeNetworkConfigDefaults(providerConfig, defaults)
+	if err != nil || merged == nil || merged.Raw == nil {
+		return merged, err
+	}
+
+	networkConfig := &calico.NetworkConfig{}
+	if _, _, err := decoder.Decode(merged.Raw, nil, networkConfig); err != nil {
+		return nil, err
+	}
+	if networkConfig.Profile == nil {
+		return merged, nil
+	}
+
+	profile, ok := profiles[*networkConfig.Profile]
+	if !ok {
+		return nil, field.NotSupported(fldPath.Child("profile"), *networkConfig.Profile, sets.List(sets.KeySet(profiles)))
+	}
+	if providerConfig, err = MergeNetworkConfigDefaults(providerConfig, &profile.NetworkConfig); err != nil {
+		return nil, err
+	}
+	return MergeNetworkConfigDefaults(providerConfig, defaults)
+}
+
+// MergeNetworkConfigDefaults merges the given provider config over the given defaults and returns the merged provider
+// config. The provider config is applied as a JSON merge patch (RFC 7386), i.e. every setting of the provider config
+// wins over the defaults, including explicit false values, while explicit null values remove the default setting.
+// The defaults are converted to the version of the provider config before, the merged provider config keeps it.
+func MergeNetworkConfigDefaults(providerConfig *runtime.RawExtension, defaults *calicov1alpha1.NetworkConfig) (*runtime.RawExtension, error) {
+	if defaults == nil {
+		return providerConfig, nil
+	}
+
+	version, err := apiVersion(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultsObj runtime.Object
+	if version == calicov1alpha2.SchemeGroupVersion.String() {
+		internalDefaults := &calico.NetworkConfig{}
+		if err := Scheme.Convert(defaults, internalDefaults, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert network config defaults: %w", err)
+		}
+		v1alpha2Defaults := &calicov1alpha2.NetworkConfig{}
+		if err := Scheme.Convert(internalDefaults, v1alpha2Defaults, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert network config defaults: %w", err)
+		}
+		v1alpha2Defaults.APIVersion = calicov1alpha2.SchemeGroupVersion.String()
+		v1alpha2Defaults.Kind = "NetworkConfig"
+		defaultsObj = v1alpha2Defaults
+	} else {
+		defaults = defaults.DeepCopy()
+		defaults.APIVersion = calicov1alpha1.SchemeGroupVersion.String()
+		defaults.Kind = "NetworkConfig"
+		defaultsObj = defaults
+	}
+
+	merged, err := json.Marshal(defaultsObj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal network config defaults: %w", err)
+	}
+
+	if providerConfig != nil && providerConfig.Raw != nil {
+		if merged, err = jsonpatch.MergePatch(merged, providerConfig.Raw); err != nil {
+			return nil, fmt.Errorf("failed to merge provider config with network config defaults: %w", err)
+		}
+	}
+
+	return &runtime.RawExtension{Raw: merged}, nil
+}
+
+// DerivesBackendFromOverlay returns whether the backend and the pool modes of the given provider config are derived from
+// its overlay settings. This is the case for the v1alpha1 API, while the v1alpha2 API sets them explicitly.
+func DerivesBackendFromOverlay(providerConfig *runtime.RawExtension) (bool, error) {
+	version, err := apiVersion(providerConfig)
+	if err != nil {
+		return false, err
+	}
+	return version != calicov1alpha2.SchemeGroupVersion.String(), nil
+}
+
+// apiVersion returns the API version of the given provider config. It is empty if the provider config is not set.
+func apiVersion(providerConfig *runtime.RawExtension) (string, error) {
+	if providerConfig == nil || providerConfig.Raw == nil {
+		return "", nil
+	}
+
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(providerConfig.Raw, typeMeta); err != nil {
+		return "", fmt.Errorf("failed to decode provider config: %w", err)
+	}
+	return typeMeta.APIVersion, nil
+}
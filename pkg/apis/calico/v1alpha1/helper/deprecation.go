@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+// DeprecationWarnings returns warnings for the deprecated fields set in the given network config.
+func DeprecationWarnings(networkConfig *calicov1alpha1.NetworkConfig, fldPath *field.Path) []string {
+	migration := "it is moved there automatically when the shoot is updated"
+	if enablesVXLANForIPv4(networkConfig) {
+		migration = fmt.Sprintf("it is not moved there automatically, because %s switches the IPv4 pool to VXLAN once %s is set", fldPath.Child("vxlan", "enabled"), fldPath.Child("ipv4"))
+	}
+
+	var warnings []string
+	if networkConfig.IPIP != nil {
+		warnings = append(warnings, fmt.Sprintf("%s is deprecated, use %s instead (%s)", fldPath.Child("ipip"), fldPath.Child("ipv4", "mode"), migration))
+	}
+	if networkConfig.IPAutoDetectionMethod != nil {
+		warnings = append(warnings, fmt.Sprintf("%s is deprecated, use %s instead (%s)", fldPath.Child("ipAutodetectionMethod"), fldPath.Child("ipv4", "autoDetectionMethod"), migration))
+	}
+	return warnings
+}
+
+// MigrateDeprecatedFields moves the deprecated IPIP and IPAutoDetectionMethod fields of the given network config into
+// the IPv4 section. Like the fallback of the chart values, the deprecated fields only take effect if the IPv4 section
+// is not set, otherwise they are dropped. They are dropped as well if the shoot does not use IPv4.
+// The fields are kept if VXLAN is enabled without an IPv4 section, because the VXLAN settings only apply to the IPv4
+// section, i.e. moving them would switch the IPv4 pool to VXLAN and the IPAM to calico-ipam.
+// It returns whether the network config was changed.
+func MigrateDeprecatedFields(networkConfig *calicov1alpha1.NetworkConfig, ipv4 bool) bool {
+	if networkConfig.IPIP == nil && networkConfig.IPAutoDetectionMethod == nil {
+		return false
+	}
+	if ipv4 && enablesVXLANForIPv4(networkConfig) {
+		return false
+	}
+
+	if ipv4 && networkConfig.IPv4 == nil {
+		networkConfig.IPv4 = &calicov1alpha1.IPv4{
+			Mode:                networkConfig.IPIP,
+			AutoDetectionMethod: networkConfig.IPAutoDetectionMethod,
+		}
+	}
+	networkConfig.IPIP = nil
+	networkConfig.IPAutoDetectionMethod = nil
+	return true
+}
+
+// enablesVXLANForIPv4 returns whether creating the IPv4 section of the given network config would enable VXLAN for the
+// IPv4 pool.
+func enablesVXLANForIPv4(networkConfig *calicov1alpha1.NetworkConfig) bool {
+	return networkConfig.IPv4 == nil && networkConfig.VXLAN != nil && networkConfig.VXLAN.Enabled
+}
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+// UsesCalicoIPAM returns true if calico-ipam is used for the given IPAM type, either explicitly or implicitly by
+// enabling vxlan for a shoot with IPv4 without setting the IPAM type. vxlan only implies calico-ipam for the IPv4 pod
+// addresses, hence the IPAM type of IPv6 single-stack shoots is host-local then.
+// It takes the settings instead of the network config, as the admission validates the internal version of the network
+// config, while the controller reconciles the v1alpha1 version.
+func UsesCalicoIPAM(ipamType string, vxlanEnabled, usesIPv4 bool) bool {
+	if ipamType != "" {
+		return ipamType == calicov1alpha1.IPAMCalico
+	}
+	return usesIPv4 && vxlanEnabled
+}
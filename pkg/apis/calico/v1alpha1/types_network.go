@@ -57,6 +57,10 @@ type IPv4 struct {
 	// https://docs.projectcalico.org/v3.8/reference/node/configuration#ip-autodetection-methods
 	// +optional
 	AutoDetectionMethod *string `json:"autoDetectionMethod,omitempty"`
+	// BlockSize is the prefix length of the address blocks of the default IPv4 pool allocated to the nodes (default: 26).
+	// It is only used by the calico-ipam.
+	// +optional
+	BlockSize *int32 `json:"blockSize,omitempty"`
 }
 
 // IPv6 contains configuration for calico ipv6 specific settings
@@ -66,8 +70,6 @@ type IPv6 struct {
 	// +optional
 	Pool *Pool `json:"pool,omitempty"`
 	// Mode is the mode for the IPv6 Pool (e.g. Always, Never, CrossSubnet)
-	// TODO: VXLAN also supports CrossSubnet for VXLAN. Why is this not supported?
-	// vxlan pools accept only Always and Never (unchecked)
 	// +optional
 	Mode *PoolMode `json:"mode,omitempty"`
 	// AutoDetectionMethod is the method to use to autodetect the IPv6 address for this host. This is only used when the IPv6 address is being autodetected.
@@ -78,6 +80,10 @@ type IPv6 struct {
 	// Per default, source network address translation is disabled.
 	// +optional
 	SourceNATEnabled *bool `json:"sourceNATEnabled,omitempty"`
+	// BlockSize is the prefix length of the address blocks of the default IPv6 pool allocated to the nodes (default: 122).
+	// It is only used by the calico-ipam.
+	// +optional
+	BlockSize *int32 `json:"blockSize,omitempty"`
 }
 
 // +genclient
@@ -86,6 +92,10 @@ type IPv6 struct {
 // NetworkConfig configuration for the calico networking plugin
 type NetworkConfig struct {
 	metav1.TypeMeta `json:",inline"`
+	// Profile is the name of a network config profile of the operator whose settings are applied unless this network
+	// config sets them.
+	// +optional
+	Profile *string `json:"profile,omitempty"`
 	// Backend defines whether a backend should be used or not (e.g., bird or none)
 	// +optional
 	Backend *Backend `json:"backend,omitempty"`
@@ -135,6 +145,9 @@ type NetworkConfig struct {
 
 	// WireguardEncryption is the option to enable node to node wireguard encryption
 	WireguardEncryption bool `json:"wireguardEncryption,omitempty"`
+	// Wireguard contains further settings of the WireGuard encryption.
+	// +optional
+	Wireguard *Wireguard `json:"wireguard,omitempty"`
 
 	// BirdExporter configures the bird metrics exporter.
 	BirdExporter *BirdExporter `json:"birdExporter,omitempty"`
@@ -144,6 +157,26 @@ type NetworkConfig struct {
 
 	// ServiceLoopPrevention configures the Felix service loop prevention option.
 	ServiceLoopPrevention *ServiceLoopPrevention `json:"serviceLoopPrevention,omitempty"`
+
+	// BGP configures the BGP routing of calico-node, e.g. peering with external routers or route reflectors.
+	// It is only supported in conjunction with the bird backend.
+	// +optional
+	BGP *BGP `json:"bgp,omitempty"`
+
+	// IPPools is a list of additional calico IP pools, e.g. to assign different address ranges to worker pools or namespaces.
+	// Additional IP pools require the calico-ipam.
+	// +optional
+	IPPools []IPPool `json:"ipPools,omitempty"`
+
+	// Felix contains settings of felix, which are passed to calico-node as environment variables.
+	// Only an allowlisted set of settings is supported.
+	// +optional
+	Felix *FelixConfiguration `json:"felix,omitempty"`
+
+	// WorkerPools contains settings of calico-node which differ for the nodes of individual worker pools.
+	// calico-node is deployed as a separate DaemonSet for each of the worker pools.
+	// +optional
+	WorkerPools []WorkerPool `json:"workerPools,omitempty"`
 }
 
 type ServiceLoopPrevention string
@@ -159,6 +192,142 @@ const (
 // NetworkStatus contains information about created Network resources.
 type NetworkStatus struct {
 	metav1.TypeMeta `json:",inline"`
+
+	// Backend is the effective backend of calico-node (e.g. bird, vxlan or none).
+	// +optional
+	Backend *Backend `json:"backend,omitempty"`
+	// IPAM is the effective IPAM plugin (e.g. host-local or calico-ipam).
+	// +optional
+	IPAM *string `json:"ipam,omitempty"`
+	// Dataplane is the effective dataplane of felix (e.g. iptables, nftables or ebpf).
+	// +optional
+	Dataplane *Dataplane `json:"dataplane,omitempty"`
+	// OverlayEnabled indicates whether the pod traffic between nodes is encapsulated.
+	OverlayEnabled bool `json:"overlayEnabled"`
+	// VXLANEnabled indicates whether vxlan is used to encapsulate the pod traffic between nodes.
+	VXLANEnabled bool `json:"vxlanEnabled"`
+	// IPv4 contains the effective settings of the IPv4 pool, if the shoot uses IPv4.
+	// +optional
+	IPv4 *IPFamilyStatus `json:"ipv4,omitempty"`
+	// IPv6 contains the effective settings of the IPv6 pool, if the shoot uses IPv6.
+	// +optional
+	IPv6 *IPFamilyStatus `json:"ipv6,omitempty"`
+	// VethMTU is the MTU of the pod interfaces if it is set explicitly or derived from the MTU of the node network.
+	// +optional
+	VethMTU *string `json:"vethMTU,omitempty"`
+	// Wireguard contains the progress of the WireGuard encryption on the nodes, if it is enabled.
+	// +optional
+	Wireguard *WireguardStatus `json:"wireguard,omitempty"`
+	// Profile is the network config profile of the operator which is applied, if any.
+	// +optional
+	Profile *ProfileStatus `json:"profile,omitempty"`
+	// Migration contains the progress of an ongoing migration of the pod network, if any.
+	// +optional
+	Migration *MigrationStatus `json:"migration,omitempty"`
+}
+
+// WireguardStatus contains the progress of the WireGuard encryption on the nodes.
+type WireguardStatus struct {
+	// EncryptedNodes is the number of nodes which have a WireGuard public key for all IP families of the shoot.
+	EncryptedNodes int32 `json:"encryptedNodes"`
+	// PendingNodes is the number of nodes which do not have a WireGuard public key for all IP families of the shoot yet.
+	PendingNodes int32 `json:"pendingNodes"`
+	// UnsupportedNodes are the names of the nodes whose kernel does not support WireGuard.
+	// +optional
+	UnsupportedNodes []string `json:"unsupportedNodes,omitempty"`
+	// KeyRotation is the identifier of the last completed rotation of the WireGuard keys.
+	// +optional
+	KeyRotation *string `json:"keyRotation,omitempty"`
+}
+
+// ProfileStatus contains the network config profile of the operator which is applied.
+type ProfileStatus struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+	// Version is the version of the profile.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// MigrationStatus contains the progress of a migration of the pod network.
+type MigrationStatus struct {
+	// Type is the type of the migration.
+	Type MigrationType `json:"type"`
+	// Phase is the current phase of the migration.
+	Phase MigrationPhase `json:"phase"`
+	// Nodes contains the progress of the migration per node.
+	// +optional
+	Nodes []NodeMigrationStatus `json:"nodes,omitempty"`
+}
+
+// MigrationType is the type of a migration of the pod network.
+type MigrationType string
+
+const (
+	// MigrationTypeOverlayEnablement is the migration from the non-overlay to the overlay network.
+	MigrationTypeOverlayEnablement MigrationType = "OverlayEnablement"
+	// MigrationTypeIPIPToVXLAN is the migration of the encapsulation of the default IPv4 pool from IPIP to VXLAN.
+	MigrationTypeIPIPToVXLAN MigrationType = "IPIPToVXLAN"
+	// MigrationTypeHostLocalToCalicoIPAM is the migration of the IPAM of the pod network from host-local to calico-ipam.
+	MigrationTypeHostLocalToCalicoIPAM MigrationType = "HostLocalToCalicoIPAM"
+	// MigrationTypeIPTablesToEBPF is the migration of the dataplane from iptables to eBPF.
+	MigrationTypeIPTablesToEBPF MigrationType = "IPTablesToEBPF"
+	// MigrationTypeEBPFToIPTables is the migration of the dataplane from eBPF to iptables.
+	MigrationTypeEBPFToIPTables MigrationType = "EBPFToIPTables"
+	// MigrationTypeIPv4ToDualStack is the migration of the pod network from IPv4 to dual-stack.
+	MigrationTypeIPv4ToDualStack MigrationType = "IPv4ToDualStack"
+	// MigrationTypeWireguardKeyRotation is the rotation of the WireGuard keys of the nodes.
+	MigrationTypeWireguardKeyRotation MigrationType = "WireguardKeyRotation"
+)
+
+// MigrationPhase is the phase of a migration of the pod network.
+type MigrationPhase string
+
+const (
+	// MigrationPhasePreparing is the phase in which the nodes are prepared for the migration, while the pod
+	// traffic is still handled the previous way.
+	MigrationPhasePreparing MigrationPhase = "Preparing"
+	// MigrationPhaseMigrating is the phase in which the nodes are switched to the desired configuration one by one.
+	MigrationPhaseMigrating MigrationPhase = "Migrating"
+)
+
+// NodeMigrationStatus contains the progress of a migration of the pod network on a node.
+type NodeMigrationStatus struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+	// Ready indicates whether the node is ready for the next phase of the migration.
+	Ready bool `json:"ready"`
+	// Message describes why the node is not ready yet.
+	// +optional
+	Message *string `json:"message,omitempty"`
+	// PreviousWireguardPublicKeys are the WireGuard public keys of the node before the rotation of the WireGuard keys.
+	// +optional
+	PreviousWireguardPublicKeys []string `json:"previousWireguardPublicKeys,omitempty"`
+}
+
+// Dataplane is the dataplane used by felix.
+type Dataplane string
+
+const (
+	// DataplaneIPTables is the iptables dataplane.
+	DataplaneIPTables Dataplane = "iptables"
+	// DataplaneNFTables is the nftables dataplane.
+	DataplaneNFTables Dataplane = "nftables"
+	// DataplaneEBPF is the eBPF dataplane.
+	DataplaneEBPF Dataplane = "ebpf"
+)
+
+// IPFamilyStatus contains the effective settings of the default IP pool of an IP family.
+type IPFamilyStatus struct {
+	// Pool is the effective type of the ip pool for the tunnel interface (e.g. ipip or vxlan).
+	Pool Pool `json:"pool"`
+	// Mode is the effective mode of the pool (e.g. Always, Never, CrossSubnet).
+	Mode PoolMode `json:"mode"`
+	// AutoDetectionMethod is the effective method to autodetect the address of the nodes.
+	// +optional
+	AutoDetectionMethod *string `json:"autoDetectionMethod,omitempty"`
+	// BlockSize is the effective prefix length of the address blocks allocated to the nodes.
+	BlockSize int32 `json:"blockSize"`
 }
 
 // IPAM defines the block that configuration for the ip assignment plugin to be used
@@ -168,6 +337,22 @@ type IPAM struct {
 	// CIDR defines the CIDR block to be used
 	// +optional
 	CIDR *CIDR `json:"cidr,omitempty"`
+	// Config configures the calico-ipam. It is rendered as the IPAMConfig resource of calico.
+	// +optional
+	Config *IPAMConfig `json:"config,omitempty"`
+}
+
+// IPAMConfig contains the configuration of the calico-ipam.
+type IPAMConfig struct {
+	// StrictAffinity forbids the nodes to borrow addresses from the blocks of other nodes (default: false).
+	// +optional
+	StrictAffinity *bool `json:"strictAffinity,omitempty"`
+	// MaxBlocksPerHost is the maximum number of blocks a node may allocate (default: 0, i.e. unlimited).
+	// +optional
+	MaxBlocksPerHost *int32 `json:"maxBlocksPerHost,omitempty"`
+	// AutoAllocateBlocks allows the nodes to allocate new blocks on demand (default: true).
+	// +optional
+	AutoAllocateBlocks *bool `json:"autoAllocateBlocks,omitempty"`
 }
 
 // Typha defines the block with configurations for calico typha
@@ -244,6 +429,31 @@ type VXLAN struct {
 	Enabled bool `json:"enabled"`
 }
 
+// Wireguard contains further settings of the WireGuard encryption.
+// The routing table of the WireGuard routes is not configurable, as felix allocates it from its route table ranges.
+type Wireguard struct {
+	// HostEncryptionEnabled enables the encryption of the host network traffic between nodes in addition to the pod
+	// traffic.
+	// +optional
+	HostEncryptionEnabled *bool `json:"hostEncryptionEnabled,omitempty"`
+	// RoutingRulePriority is the priority of the routing rule which directs the encrypted traffic to the WireGuard
+	// routing table (default: 99).
+	// +optional
+	RoutingRulePriority *int32 `json:"routingRulePriority,omitempty"`
+	// InterfaceName is the name of the IPv4 WireGuard interface (default: wireguard.cali).
+	// +optional
+	InterfaceName *string `json:"interfaceName,omitempty"`
+	// InterfaceNameV6 is the name of the IPv6 WireGuard interface (default: wg-v6.cali).
+	// +optional
+	InterfaceNameV6 *string `json:"interfaceNameV6,omitempty"`
+	// ListeningPort is the listening port of the IPv4 WireGuard interface (default: 51820).
+	// +optional
+	ListeningPort *int32 `json:"listeningPort,omitempty"`
+	// ListeningPortV6 is the listening port of the IPv6 WireGuard interface (default: 51821).
+	// +optional
+	ListeningPortV6 *int32 `json:"listeningPortV6,omitempty"`
+}
+
 type BirdExporter struct {
 	// Enabled enables the bird metrics exporter.
 	Enabled bool `json:"enabled"`
@@ -256,3 +466,203 @@ type Multus struct {
 	// +optional
 	InstallCNIPlugins *bool `json:"installCNIPlugins,omitempty"`
 }
+
+// BGP contains configuration for the BGP routing of calico-node.
+type BGP struct {
+	// ASNumber is the default AS number used by the nodes of the cluster (default: 64512).
+	// +optional
+	ASNumber *uint32 `json:"asNumber,omitempty"`
+	// NodeToNodeMeshEnabled enables the full node-to-node BGP mesh.
+	// Defaults to true unless route reflectors are configured.
+	// +optional
+	NodeToNodeMeshEnabled *bool `json:"nodeToNodeMeshEnabled,omitempty"`
+	// Peers is a list of BGP peers of the cluster nodes, e.g. top-of-rack routers.
+	// +optional
+	Peers []BGPPeer `json:"peers,omitempty"`
+	// RouteReflector configures a set of cluster nodes to act as route reflectors for all other nodes.
+	// +optional
+	RouteReflector *BGPRouteReflector `json:"routeReflector,omitempty"`
+	// ServiceClusterIPs configures the advertisement of service cluster IPs.
+	// +optional
+	ServiceClusterIPs *BGPServiceClusterIPs `json:"serviceClusterIPs,omitempty"`
+	// ServiceExternalIPs is a list of CIDRs of service external IPs to advertise.
+	// +optional
+	ServiceExternalIPs []CIDR `json:"serviceExternalIPs,omitempty"`
+	// ServiceLoadBalancerIPs is a list of CIDRs of service load balancer IPs to advertise.
+	// +optional
+	ServiceLoadBalancerIPs []CIDR `json:"serviceLoadBalancerIPs,omitempty"`
+}
+
+// BGPPeer describes a BGP peer of the cluster nodes.
+type BGPPeer struct {
+	// Name is the name of the BGPPeer resource.
+	Name string `json:"name"`
+	// PeerIP is the IP address of an external BGP peer. Either PeerIP or PeerSelector must be set.
+	// +optional
+	PeerIP *string `json:"peerIP,omitempty"`
+	// ASNumber is the AS number of the external BGP peer. It is required if PeerIP is set.
+	// +optional
+	ASNumber *uint32 `json:"asNumber,omitempty"`
+	// NodeSelector is a calico selector for the nodes that should have this peering (default: all nodes).
+	// +optional
+	NodeSelector *string `json:"nodeSelector,omitempty"`
+	// PeerSelector is a calico selector for the cluster nodes that act as BGP peers.
+	// Either PeerIP or PeerSelector must be set.
+	// +optional
+	PeerSelector *string `json:"peerSelector,omitempty"`
+}
+
+// BGPRouteReflector configures cluster nodes as BGP route reflectors.
+type BGPRouteReflector struct {
+	// NodeSelector is a calico selector for the nodes acting as route reflectors, e.g. `route-reflector == 'true'`.
+	// The selected nodes need to carry the `projectcalico.org/RouteReflectorClusterID` annotation.
+	NodeSelector string `json:"nodeSelector"`
+}
+
+// BGPServiceClusterIPs configures the advertisement of service cluster IPs.
+type BGPServiceClusterIPs struct {
+	// CIDRs is a list of CIDRs of service cluster IPs to advertise (default: the service CIDRs of the shoot).
+	// The CIDRs must be within the service CIDRs of the shoot.
+	// +optional
+	CIDRs []CIDR `json:"cidrs,omitempty"`
+}
+
+// IPPoolAllowedUse is a use of the addresses of an IP pool.
+type IPPoolAllowedUse string
+
+const (
+	// IPPoolAllowedUseWorkload allows to assign addresses of the pool to pods.
+	IPPoolAllowedUseWorkload IPPoolAllowedUse = "Workload"
+	// IPPoolAllowedUseTunnel allows to assign addresses of the pool to the tunnel devices of the nodes.
+	IPPoolAllowedUseTunnel IPPoolAllowedUse = "Tunnel"
+	// IPPoolAllowedUseLoadBalancer allows to assign addresses of the pool to services of type LoadBalancer.
+	IPPoolAllowedUseLoadBalancer IPPoolAllowedUse = "LoadBalancer"
+)
+
+// IPPool describes an additional calico IP pool.
+type IPPool struct {
+	// Name is the name of the IPPool resource.
+	Name string `json:"name"`
+	// CIDR is the address range of the pool. It must not overlap with the node, pod or service CIDRs of the shoot or other IP pools.
+	CIDR CIDR `json:"cidr"`
+	// BlockSize is the prefix length of the address blocks allocated to the nodes.
+	// Defaults to the block size of the default IP pool of the same IP family.
+	// +optional
+	BlockSize *int32 `json:"blockSize,omitempty"`
+	// Encapsulation is the encapsulation used for traffic of the pool (e.g. ipip or vxlan).
+	// Defaults to the encapsulation of the default IP pool of the same IP family.
+	// +optional
+	Encapsulation *Pool `json:"encapsulation,omitempty"`
+	// Mode is the encapsulation mode of the pool (e.g. Always, Never, CrossSubnet).
+	// Defaults to the mode of the default IP pool of the same IP family.
+	// +optional
+	Mode *PoolMode `json:"mode,omitempty"`
+	// NATOutgoing enables the masquerading of traffic from the pool to external destinations.
+	// Defaults to the setting of the default IP pool of the same IP family.
+	// +optional
+	NATOutgoing *bool `json:"natOutgoing,omitempty"`
+	// NodeSelector is a calico selector for the nodes that allocate addresses from the pool (default: all nodes).
+	// +optional
+	NodeSelector *string `json:"nodeSelector,omitempty"`
+	// NamespaceSelector is a calico selector for the namespaces whose pods get addresses from the pool (default: all namespaces).
+	// +optional
+	NamespaceSelector *string `json:"namespaceSelector,omitempty"`
+	// AllowedUses are the uses of the addresses of the pool (default: Workload and Tunnel).
+	// +optional
+	AllowedUses []IPPoolAllowedUse `json:"allowedUses,omitempty"`
+}
+
+// FelixConfiguration contains the allowlisted settings of felix. The names of the fields match the ones of the
+// FelixConfiguration resource of calico.
+type FelixConfiguration struct {
+	// LogSeverityScreen is the minimum severity of the logs written by felix (Debug, Info, Warning, Error or Fatal).
+	// +optional
+	LogSeverityScreen *string `json:"logSeverityScreen,omitempty"`
+	// RouteRefreshInterval is the period at which felix re-checks the routes in the dataplane.
+	// +optional
+	RouteRefreshInterval *metav1.Duration `json:"routeRefreshInterval,omitempty"`
+	// IptablesRefreshInterval is the period at which felix re-checks the iptables rules in the dataplane.
+	// +optional
+	IptablesRefreshInterval *metav1.Duration `json:"iptablesRefreshInterval,omitempty"`
+	// NftablesRefreshInterval is the period at which felix re-checks the nftables rules in the dataplane.
+	// +optional
+	NftablesRefreshInterval *metav1.Duration `json:"nftablesRefreshInterval,omitempty"`
+	// BPFConntrackTimeouts are the timeouts of the connection tracking of the eBPF dataplane.
+	// +optional
+	BPFConntrackTimeouts *FelixConntrackTimeouts `json:"bpfConntrackTimeouts,omitempty"`
+	// FailsafeInboundHostPorts are the ports on which incoming traffic to the nodes is always allowed, irrespective of
+	// the network policies.
+	// +optional
+	FailsafeInboundHostPorts []FelixProtoPort `json:"failsafeInboundHostPorts,omitempty"`
+	// FailsafeOutboundHostPorts are the ports to which outgoing traffic from the nodes is always allowed, irrespective of
+	// the network policies.
+	// +optional
+	FailsafeOutboundHostPorts []FelixProtoPort `json:"failsafeOutboundHostPorts,omitempty"`
+	// NATPortRange is the range of source ports used for masquerading, e.g. 32768:65535 (default).
+	// +optional
+	NATPortRange *string `json:"natPortRange,omitempty"`
+	// FlowLogsFlushInterval is the period at which felix exports the flow logs.
+	// +optional
+	FlowLogsFlushInterval *metav1.Duration `json:"flowLogsFlushInterval,omitempty"`
+	// FlowLogsPolicyEvaluationMode defines how felix evaluates the policies of active flows (None or Continuous).
+	// +optional
+	FlowLogsPolicyEvaluationMode *string `json:"flowLogsPolicyEvaluationMode,omitempty"`
+}
+
+// FelixConntrackTimeouts contains the timeouts of the connection tracking of the eBPF dataplane.
+type FelixConntrackTimeouts struct {
+	// CreationGracePeriod is the time after which incomplete connections are removed.
+	// +optional
+	CreationGracePeriod *metav1.Duration `json:"creationGracePeriod,omitempty"`
+	// TCPSynSent is the timeout of TCP connections for which only a SYN has been seen.
+	// +optional
+	TCPSynSent *metav1.Duration `json:"tcpSynSent,omitempty"`
+	// TCPEstablished is the timeout of established TCP connections.
+	// +optional
+	TCPEstablished *metav1.Duration `json:"tcpEstablished,omitempty"`
+	// TCPFinsSeen is the timeout of TCP connections for which FINs have been seen in both directions.
+	// +optional
+	TCPFinsSeen *metav1.Duration `json:"tcpFinsSeen,omitempty"`
+	// TCPResetSeen is the timeout of TCP connections for which a RST has been seen.
+	// +optional
+	TCPResetSeen *metav1.Duration `json:"tcpResetSeen,omitempty"`
+	// UDPTimeout is the timeout of UDP connections.
+	// +optional
+	UDPTimeout *metav1.Duration `json:"udpTimeout,omitempty"`
+	// GenericTimeout is the timeout of connections of other protocols.
+	// +optional
+	GenericTimeout *metav1.Duration `json:"genericTimeout,omitempty"`
+	// ICMPTimeout is the timeout of ICMP connections.
+	// +optional
+	ICMPTimeout *metav1.Duration `json:"icmpTimeout,omitempty"`
+}
+
+// FelixProtoPort is a combination of protocol, port and CIDR.
+type FelixProtoPort struct {
+	// Protocol is the protocol of the traffic (tcp, udp or sctp, default: tcp).
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+	// Port is the port of the traffic.
+	Port int32 `json:"port"`
+	// Net is the CIDR of the remote addresses of the traffic (default: all addresses).
+	// +optional
+	Net *string `json:"net,omitempty"`
+}
+
+// WorkerPool contains settings of calico-node which differ for the nodes of a worker pool.
+type WorkerPool struct {
+	// Name is the name of the worker pool in the shoot.
+	Name string `json:"name"`
+	// EbpfDataplane overrides whether the eBPF dataplane is enabled on the nodes of the worker pool.
+	// +optional
+	EbpfDataplane *EbpfDataplane `json:"ebpfDataplane,omitempty"`
+	// IPv4AutoDetectionMethod overrides the method to autodetect the IPv4 address of the nodes of the worker pool.
+	// +optional
+	IPv4AutoDetectionMethod *string `json:"ipv4AutoDetectionMethod,omitempty"`
+	// IPv6AutoDetectionMethod overrides the method to autodetect the IPv6 address of the nodes of the worker pool.
+	// +optional
+	IPv6AutoDetectionMethod *string `json:"ipv6AutoDetectionMethod,omitempty"`
+	// VethMTU overrides the MTU of the pod interfaces and tunnel devices on the nodes of the worker pool.
+	// +optional
+	VethMTU *string `json:"vethMTU,omitempty"`
+}
@@ -13,6 +13,7 @@ import (
 	unsafe "unsafe"
 
 	calico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -34,6 +35,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BGP)(nil), (*calico.BGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BGP_To_calico_BGP(a.(*BGP), b.(*calico.BGP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGP)(nil), (*BGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGP_To_v1alpha1_BGP(a.(*calico.BGP), b.(*BGP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPPeer)(nil), (*calico.BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BGPPeer_To_calico_BGPPeer(a.(*BGPPeer), b.(*calico.BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPPeer)(nil), (*BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPPeer_To_v1alpha1_BGPPeer(a.(*calico.BGPPeer), b.(*BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPRouteReflector)(nil), (*calico.BGPRouteReflector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BGPRouteReflector_To_calico_BGPRouteReflector(a.(*BGPRouteReflector), b.(*calico.BGPRouteReflector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPRouteReflector)(nil), (*BGPRouteReflector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPRouteReflector_To_v1alpha1_BGPRouteReflector(a.(*calico.BGPRouteReflector), b.(*BGPRouteReflector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPServiceClusterIPs)(nil), (*calico.BGPServiceClusterIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(a.(*BGPServiceClusterIPs), b.(*calico.BGPServiceClusterIPs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPServiceClusterIPs)(nil), (*BGPServiceClusterIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPServiceClusterIPs_To_v1alpha1_BGPServiceClusterIPs(a.(*calico.BGPServiceClusterIPs), b.(*BGPServiceClusterIPs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BirdExporter)(nil), (*calico.BirdExporter)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BirdExporter_To_calico_BirdExporter(a.(*BirdExporter), b.(*calico.BirdExporter), scope)
 	}); err != nil {
@@ -54,6 +95,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FelixConfiguration)(nil), (*calico.FelixConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FelixConfiguration_To_calico_FelixConfiguration(a.(*FelixConfiguration), b.(*calico.FelixConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixConfiguration)(nil), (*FelixConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixConfiguration_To_v1alpha1_FelixConfiguration(a.(*calico.FelixConfiguration), b.(*FelixConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FelixConntrackTimeouts)(nil), (*calico.FelixConntrackTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(a.(*FelixConntrackTimeouts), b.(*calico.FelixConntrackTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixConntrackTimeouts)(nil), (*FelixConntrackTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixConntrackTimeouts_To_v1alpha1_FelixConntrackTimeouts(a.(*calico.FelixConntrackTimeouts), b.(*FelixConntrackTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FelixProtoPort)(nil), (*calico.FelixProtoPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FelixProtoPort_To_calico_FelixProtoPort(a.(*FelixProtoPort), b.(*calico.FelixProtoPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixProtoPort)(nil), (*FelixProtoPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixProtoPort_To_v1alpha1_FelixProtoPort(a.(*calico.FelixProtoPort), b.(*FelixProtoPort), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPAM)(nil), (*calico.IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IPAM_To_calico_IPAM(a.(*IPAM), b.(*calico.IPAM), scope)
 	}); err != nil {
@@ -64,6 +135,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IPAMConfig)(nil), (*calico.IPAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAMConfig_To_calico_IPAMConfig(a.(*IPAMConfig), b.(*calico.IPAMConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPAMConfig)(nil), (*IPAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPAMConfig_To_v1alpha1_IPAMConfig(a.(*calico.IPAMConfig), b.(*IPAMConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPFamilyStatus)(nil), (*calico.IPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPFamilyStatus_To_calico_IPFamilyStatus(a.(*IPFamilyStatus), b.(*calico.IPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPFamilyStatus)(nil), (*IPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPFamilyStatus_To_v1alpha1_IPFamilyStatus(a.(*calico.IPFamilyStatus), b.(*IPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPool)(nil), (*calico.IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPool_To_calico_IPPool(a.(*IPPool), b.(*calico.IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPPool)(nil), (*IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPPool_To_v1alpha1_IPPool(a.(*calico.IPPool), b.(*IPPool), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPv4)(nil), (*calico.IPv4)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IPv4_To_calico_IPv4(a.(*IPv4), b.(*calico.IPv4), scope)
 	}); err != nil {
@@ -84,6 +185,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MigrationStatus)(nil), (*calico.MigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MigrationStatus_To_calico_MigrationStatus(a.(*MigrationStatus), b.(*calico.MigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.MigrationStatus)(nil), (*MigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_MigrationStatus_To_v1alpha1_MigrationStatus(a.(*calico.MigrationStatus), b.(*MigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Multus)(nil), (*calico.Multus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Multus_To_calico_Multus(a.(*Multus), b.(*calico.Multus), scope)
 	}); err != nil {
@@ -114,6 +225,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeMigrationStatus)(nil), (*calico.NodeMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeMigrationStatus_To_calico_NodeMigrationStatus(a.(*NodeMigrationStatus), b.(*calico.NodeMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.NodeMigrationStatus)(nil), (*NodeMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_NodeMigrationStatus_To_v1alpha1_NodeMigrationStatus(a.(*calico.NodeMigrationStatus), b.(*NodeMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Overlay)(nil), (*calico.Overlay)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Overlay_To_calico_Overlay(a.(*Overlay), b.(*calico.Overlay), scope)
 	}); err != nil {
@@ -124,6 +245,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProfileStatus)(nil), (*calico.ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProfileStatus_To_calico_ProfileStatus(a.(*ProfileStatus), b.(*calico.ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.ProfileStatus)(nil), (*ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_ProfileStatus_To_v1alpha1_ProfileStatus(a.(*calico.ProfileStatus), b.(*ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SnatToUpstreamDNS)(nil), (*calico.SnatToUpstreamDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(a.(*SnatToUpstreamDNS), b.(*calico.SnatToUpstreamDNS), scope)
 	}); err != nil {
@@ -154,6 +285,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Wireguard)(nil), (*calico.Wireguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Wireguard_To_calico_Wireguard(a.(*Wireguard), b.(*calico.Wireguard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.Wireguard)(nil), (*Wireguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_Wireguard_To_v1alpha1_Wireguard(a.(*calico.Wireguard), b.(*Wireguard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WireguardStatus)(nil), (*calico.WireguardStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WireguardStatus_To_calico_WireguardStatus(a.(*WireguardStatus), b.(*calico.WireguardStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.WireguardStatus)(nil), (*WireguardStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_WireguardStatus_To_v1alpha1_WireguardStatus(a.(*calico.WireguardStatus), b.(*WireguardStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPool)(nil), (*calico.WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPool_To_calico_WorkerPool(a.(*WorkerPool), b.(*calico.WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.WorkerPool)(nil), (*WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_WorkerPool_To_v1alpha1_WorkerPool(a.(*calico.WorkerPool), b.(*WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -179,6 +340,106 @@ func Convert_calico_AutoScaling_To_v1alpha1_AutoScaling(in *calico.AutoScaling,
 	return autoConvert_calico_AutoScaling_To_v1alpha1_AutoScaling(in, out, s)
 }
 
+func autoConvert_v1alpha1_BGP_To_calico_BGP(in *BGP, out *calico.BGP, s conversion.Scope) error {
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeToNodeMeshEnabled = (*bool)(unsafe.Pointer(in.NodeToNodeMeshEnabled))
+	out.Peers = *(*[]calico.BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflector = (*calico.BGPRouteReflector)(unsafe.Pointer(in.RouteReflector))
+	out.ServiceClusterIPs = (*calico.BGPServiceClusterIPs)(unsafe.Pointer(in.ServiceClusterIPs))
+	out.ServiceExternalIPs = *(*[]calico.CIDR)(unsafe.Pointer(&in.ServiceExternalIPs))
+	out.ServiceLoadBalancerIPs = *(*[]calico.CIDR)(unsafe.Pointer(&in.ServiceLoadBalancerIPs))
+	return nil
+}
+
+// Convert_v1alpha1_BGP_To_calico_BGP is an autogenerated conversion function.
+func Convert_v1alpha1_BGP_To_calico_BGP(in *BGP, out *calico.BGP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BGP_To_calico_BGP(in, out, s)
+}
+
+func autoConvert_calico_BGP_To_v1alpha1_BGP(in *calico.BGP, out *BGP, s conversion.Scope) error {
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeToNodeMeshEnabled = (*bool)(unsafe.Pointer(in.NodeToNodeMeshEnabled))
+	out.Peers = *(*[]BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflector = (*BGPRouteReflector)(unsafe.Pointer(in.RouteReflector))
+	out.ServiceClusterIPs = (*BGPServiceClusterIPs)(unsafe.Pointer(in.ServiceClusterIPs))
+	out.ServiceExternalIPs = *(*[]CIDR)(unsafe.Pointer(&in.ServiceExternalIPs))
+	out.ServiceLoadBalancerIPs = *(*[]CIDR)(unsafe.Pointer(&in.ServiceLoadBalancerIPs))
+	return nil
+}
+
+// Convert_calico_BGP_To_v1alpha1_BGP is an autogenerated conversion function.
+func Convert_calico_BGP_To_v1alpha1_BGP(in *calico.BGP, out *BGP, s conversion.Scope) error {
+	return autoConvert_calico_BGP_To_v1alpha1_BGP(in, out, s)
+}
+
+func autoConvert_v1alpha1_BGPPeer_To_calico_BGPPeer(in *BGPPeer, out *calico.BGPPeer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PeerIP = (*string)(unsafe.Pointer(in.PeerIP))
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.PeerSelector = (*string)(unsafe.Pointer(in.PeerSelector))
+	return nil
+}
+
+// Convert_v1alpha1_BGPPeer_To_calico_BGPPeer is an autogenerated conversion function.
+func Convert_v1alpha1_BGPPeer_To_calico_BGPPeer(in *BGPPeer, out *calico.BGPPeer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BGPPeer_To_calico_BGPPeer(in, out, s)
+}
+
+func autoConvert_calico_BGPPeer_To_v1alpha1_BGPPeer(in *calico.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PeerIP = (*string)(unsafe.Pointer(in.PeerIP))
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.PeerSelector = (*string)(unsafe.Pointer(in.PeerSelector))
+	return nil
+}
+
+// Convert_calico_BGPPeer_To_v1alpha1_BGPPeer is an autogenerated conversion function.
+func Convert_calico_BGPPeer_To_v1alpha1_BGPPeer(in *calico.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	return autoConvert_calico_BGPPeer_To_v1alpha1_BGPPeer(in, out, s)
+}
+
+func autoConvert_v1alpha1_BGPRouteReflector_To_calico_BGPRouteReflector(in *BGPRouteReflector, out *calico.BGPRouteReflector, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_v1alpha1_BGPRouteReflector_To_calico_BGPRouteReflector is an autogenerated conversion function.
+func Convert_v1alpha1_BGPRouteReflector_To_calico_BGPRouteReflector(in *BGPRouteReflector, out *calico.BGPRouteReflector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BGPRouteReflector_To_calico_BGPRouteReflector(in, out, s)
+}
+
+func autoConvert_calico_BGPRouteReflector_To_v1alpha1_BGPRouteReflector(in *calico.BGPRouteReflector, out *BGPRouteReflector, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_calico_BGPRouteReflector_To_v1alpha1_BGPRouteReflector is an autogenerated conversion function.
+func Convert_calico_BGPRouteReflector_To_v1alpha1_BGPRouteReflector(in *calico.BGPRouteReflector, out *BGPRouteReflector, s conversion.Scope) error {
+	return autoConvert_calico_BGPRouteReflector_To_v1alpha1_BGPRouteReflector(in, out, s)
+}
+
+func autoConvert_v1alpha1_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in *BGPServiceClusterIPs, out *calico.BGPServiceClusterIPs, s conversion.Scope) error {
+	out.CIDRs = *(*[]calico.CIDR)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs is an autogenerated conversion function.
+func Convert_v1alpha1_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in *BGPServiceClusterIPs, out *calico.BGPServiceClusterIPs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in, out, s)
+}
+
+func autoConvert_calico_BGPServiceClusterIPs_To_v1alpha1_BGPServiceClusterIPs(in *calico.BGPServiceClusterIPs, out *BGPServiceClusterIPs, s conversion.Scope) error {
+	out.CIDRs = *(*[]CIDR)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_calico_BGPServiceClusterIPs_To_v1alpha1_BGPServiceClusterIPs is an autogenerated conversion function.
+func Convert_calico_BGPServiceClusterIPs_To_v1alpha1_BGPServiceClusterIPs(in *calico.BGPServiceClusterIPs, out *BGPServiceClusterIPs, s conversion.Scope) error {
+	return autoConvert_calico_BGPServiceClusterIPs_To_v1alpha1_BGPServiceClusterIPs(in, out, s)
+}
+
 func autoConvert_v1alpha1_BirdExporter_To_calico_BirdExporter(in *BirdExporter, out *calico.BirdExporter, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -219,9 +480,106 @@ func Convert_calico_EbpfDataplane_To_v1alpha1_EbpfDataplane(in *calico.EbpfDatap
 	return autoConvert_calico_EbpfDataplane_To_v1alpha1_EbpfDataplane(in, out, s)
 }
 
+func autoConvert_v1alpha1_FelixConfiguration_To_calico_FelixConfiguration(in *FelixConfiguration, out *calico.FelixConfiguration, s conversion.Scope) error {
+	out.LogSeverityScreen = (*string)(unsafe.Pointer(in.LogSeverityScreen))
+	out.RouteRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.RouteRefreshInterval))
+	out.IptablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.IptablesRefreshInterval))
+	out.NftablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.NftablesRefreshInterval))
+	out.BPFConntrackTimeouts = (*calico.FelixConntrackTimeouts)(unsafe.Pointer(in.BPFConntrackTimeouts))
+	out.FailsafeInboundHostPorts = *(*[]calico.FelixProtoPort)(unsafe.Pointer(&in.FailsafeInboundHostPorts))
+	out.FailsafeOutboundHostPorts = *(*[]calico.FelixProtoPort)(unsafe.Pointer(&in.FailsafeOutboundHostPorts))
+	out.NATPortRange = (*string)(unsafe.Pointer(in.NATPortRange))
+	out.FlowLogsFlushInterval = (*v1.Duration)(unsafe.Pointer(in.FlowLogsFlushInterval))
+	out.FlowLogsPolicyEvaluationMode = (*string)(unsafe.Pointer(in.FlowLogsPolicyEvaluationMode))
+	return nil
+}
+
+// Convert_v1alpha1_FelixConfiguration_To_calico_FelixConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_FelixConfiguration_To_calico_FelixConfiguration(in *FelixConfiguration, out *calico.FelixConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FelixConfiguration_To_calico_FelixConfiguration(in, out, s)
+}
+
+func autoConvert_calico_FelixConfiguration_To_v1alpha1_FelixConfiguration(in *calico.FelixConfiguration, out *FelixConfiguration, s conversion.Scope) error {
+	out.LogSeverityScreen = (*string)(unsafe.Pointer(in.LogSeverityScreen))
+	out.RouteRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.RouteRefreshInterval))
+	out.IptablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.IptablesRefreshInterval))
+	out.NftablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.NftablesRefreshInterval))
+	out.BPFConntrackTimeouts = (*FelixConntrackTimeouts)(unsafe.Pointer(in.BPFConntrackTimeouts))
+	out.FailsafeInboundHostPorts = *(*[]FelixProtoPort)(unsafe.Pointer(&in.FailsafeInboundHostPorts))
+	out.FailsafeOutboundHostPorts = *(*[]FelixProtoPort)(unsafe.Pointer(&in.FailsafeOutboundHostPorts))
+	out.NATPortRange = (*string)(unsafe.Pointer(in.NATPortRange))
+	out.FlowLogsFlushInterval = (*v1.Duration)(unsafe.Pointer(in.FlowLogsFlushInterval))
+	out.FlowLogsPolicyEvaluationMode = (*string)(unsafe.Pointer(in.FlowLogsPolicyEvaluationMode))
+	return nil
+}
+
+// Convert_calico_FelixConfiguration_To_v1alpha1_FelixConfiguration is an autogenerated conversion function.
+func Convert_calico_FelixConfiguration_To_v1alpha1_FelixConfiguration(in *calico.FelixConfiguration, out *FelixConfiguration, s conversion.Scope) error {
+	return autoConvert_calico_FelixConfiguration_To_v1alpha1_FelixConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in *FelixConntrackTimeouts, out *calico.FelixConntrackTimeouts, s conversion.Scope) error {
+	out.CreationGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CreationGracePeriod))
+	out.TCPSynSent = (*v1.Duration)(unsafe.Pointer(in.TCPSynSent))
+	out.TCPEstablished = (*v1.Duration)(unsafe.Pointer(in.TCPEstablished))
+	out.TCPFinsSeen = (*v1.Duration)(unsafe.Pointer(in.TCPFinsSeen))
+	out.TCPResetSeen = (*v1.Duration)(unsafe.Pointer(in.TCPResetSeen))
+	out.UDPTimeout = (*v1.Duration)(unsafe.Pointer(in.UDPTimeout))
+	out.GenericTimeout = (*v1.Duration)(unsafe.Pointer(in.GenericTimeout))
+	out.ICMPTimeout = (*v1.Duration)(unsafe.Pointer(in.ICMPTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts is an autogenerated conversion function.
+func Convert_v1alpha1_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in *FelixConntrackTimeouts, out *calico.FelixConntrackTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in, out, s)
+}
+
+func autoConvert_calico_FelixConntrackTimeouts_To_v1alpha1_FelixConntrackTimeouts(in *calico.FelixConntrackTimeouts, out *FelixConntrackTimeouts, s conversion.Scope) error {
+	out.CreationGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CreationGracePeriod))
+	out.TCPSynSent = (*v1.Duration)(unsafe.Pointer(in.TCPSynSent))
+	out.TCPEstablished = (*v1.Duration)(unsafe.Pointer(in.TCPEstablished))
+	out.TCPFinsSeen = (*v1.Duration)(unsafe.Pointer(in.TCPFinsSeen))
+	out.TCPResetSeen = (*v1.Duration)(unsafe.Pointer(in.TCPResetSeen))
+	out.UDPTimeout = (*v1.Duration)(unsafe.Pointer(in.UDPTimeout))
+	out.GenericTimeout = (*v1.Duration)(unsafe.Pointer(in.GenericTimeout))
+	out.ICMPTimeout = (*v1.Duration)(unsafe.Pointer(in.ICMPTimeout))
+	return nil
+}
+
+// Convert_calico_FelixConntrackTimeouts_To_v1alpha1_FelixConntrackTimeouts is an autogenerated conversion function.
+func Convert_calico_FelixConntrackTimeouts_To_v1alpha1_FelixConntrackTimeouts(in *calico.FelixConntrackTimeouts, out *FelixConntrackTimeouts, s conversion.Scope) error {
+	return autoConvert_calico_FelixConntrackTimeouts_To_v1alpha1_FelixConntrackTimeouts(in, out, s)
+}
+
+func autoConvert_v1alpha1_FelixProtoPort_To_calico_FelixProtoPort(in *FelixProtoPort, out *calico.FelixProtoPort, s conversion.Scope) error {
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.Net = (*string)(unsafe.Pointer(in.Net))
+	return nil
+}
+
+// Convert_v1alpha1_FelixProtoPort_To_calico_FelixProtoPort is an autogenerated conversion function.
+func Convert_v1alpha1_FelixProtoPort_To_calico_FelixProtoPort(in *FelixProtoPort, out *calico.FelixProtoPort, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FelixProtoPort_To_calico_FelixProtoPort(in, out, s)
+}
+
+func autoConvert_calico_FelixProtoPort_To_v1alpha1_FelixProtoPort(in *calico.FelixProtoPort, out *FelixProtoPort, s conversion.Scope) error {
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.Net = (*string)(unsafe.Pointer(in.Net))
+	return nil
+}
+
+// Convert_calico_FelixProtoPort_To_v1alpha1_FelixProtoPort is an autogenerated conversion function.
+func Convert_calico_FelixProtoPort_To_v1alpha1_FelixProtoPort(in *calico.FelixProtoPort, out *FelixProtoPort, s conversion.Scope) error {
+	return autoConvert_calico_FelixProtoPort_To_v1alpha1_FelixProtoPort(in, out, s)
+}
+
 func autoConvert_v1alpha1_IPAM_To_calico_IPAM(in *IPAM, out *calico.IPAM, s conversion.Scope) error {
 	out.Type = in.Type
 	out.CIDR = (*calico.CIDR)(unsafe.Pointer(in.CIDR))
+	out.Config = (*calico.IPAMConfig)(unsafe.Pointer(in.Config))
 	return nil
 }
 
@@ -233,6 +591,7 @@ func Convert_v1alpha1_IPAM_To_calico_IPAM(in *IPAM, out *calico.IPAM, s conversi
 func autoConvert_calico_IPAM_To_v1alpha1_IPAM(in *calico.IPAM, out *IPAM, s conversion.Scope) error {
 	out.Type = in.Type
 	out.CIDR = (*CIDR)(unsafe.Pointer(in.CIDR))
+	out.Config = (*IPAMConfig)(unsafe.Pointer(in.Config))
 	return nil
 }
 
@@ -241,10 +600,97 @@ func Convert_calico_IPAM_To_v1alpha1_IPAM(in *calico.IPAM, out *IPAM, s conversi
 	return autoConvert_calico_IPAM_To_v1alpha1_IPAM(in, out, s)
 }
 
+func autoConvert_v1alpha1_IPAMConfig_To_calico_IPAMConfig(in *IPAMConfig, out *calico.IPAMConfig, s conversion.Scope) error {
+	out.StrictAffinity = (*bool)(unsafe.Pointer(in.StrictAffinity))
+	out.MaxBlocksPerHost = (*int32)(unsafe.Pointer(in.MaxBlocksPerHost))
+	out.AutoAllocateBlocks = (*bool)(unsafe.Pointer(in.AutoAllocateBlocks))
+	return nil
+}
+
+// Convert_v1alpha1_IPAMConfig_To_calico_IPAMConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IPAMConfig_To_calico_IPAMConfig(in *IPAMConfig, out *calico.IPAMConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAMConfig_To_calico_IPAMConfig(in, out, s)
+}
+
+func autoConvert_calico_IPAMConfig_To_v1alpha1_IPAMConfig(in *calico.IPAMConfig, out *IPAMConfig, s conversion.Scope) error {
+	out.StrictAffinity = (*bool)(unsafe.Pointer(in.StrictAffinity))
+	out.MaxBlocksPerHost = (*int32)(unsafe.Pointer(in.MaxBlocksPerHost))
+	out.AutoAllocateBlocks = (*bool)(unsafe.Pointer(in.AutoAllocateBlocks))
+	return nil
+}
+
+// Convert_calico_IPAMConfig_To_v1alpha1_IPAMConfig is an autogenerated conversion function.
+func Convert_calico_IPAMConfig_To_v1alpha1_IPAMConfig(in *calico.IPAMConfig, out *IPAMConfig, s conversion.Scope) error {
+	return autoConvert_calico_IPAMConfig_To_v1alpha1_IPAMConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPFamilyStatus_To_calico_IPFamilyStatus(in *IPFamilyStatus, out *calico.IPFamilyStatus, s conversion.Scope) error {
+	out.Pool = calico.Pool(in.Pool)
+	out.Mode = calico.PoolMode(in.Mode)
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = in.BlockSize
+	return nil
+}
+
+// Convert_v1alpha1_IPFamilyStatus_To_calico_IPFamilyStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IPFamilyStatus_To_calico_IPFamilyStatus(in *IPFamilyStatus, out *calico.IPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPFamilyStatus_To_calico_IPFamilyStatus(in, out, s)
+}
+
+func autoConvert_calico_IPFamilyStatus_To_v1alpha1_IPFamilyStatus(in *calico.IPFamilyStatus, out *IPFamilyStatus, s conversion.Scope) error {
+	out.Pool = Pool(in.Pool)
+	out.Mode = PoolMode(in.Mode)
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = in.BlockSize
+	return nil
+}
+
+// Convert_calico_IPFamilyStatus_To_v1alpha1_IPFamilyStatus is an autogenerated conversion function.
+func Convert_calico_IPFamilyStatus_To_v1alpha1_IPFamilyStatus(in *calico.IPFamilyStatus, out *IPFamilyStatus, s conversion.Scope) error {
+	return autoConvert_calico_IPFamilyStatus_To_v1alpha1_IPFamilyStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPool_To_calico_IPPool(in *IPPool, out *calico.IPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = calico.CIDR(in.CIDR)
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	out.Encapsulation = (*calico.Pool)(unsafe.Pointer(in.Encapsulation))
+	out.Mode = (*calico.PoolMode)(unsafe.Pointer(in.Mode))
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.NamespaceSelector = (*string)(unsafe.Pointer(in.NamespaceSelector))
+	out.AllowedUses = *(*[]calico.IPPoolAllowedUse)(unsafe.Pointer(&in.AllowedUses))
+	return nil
+}
+
+// Convert_v1alpha1_IPPool_To_calico_IPPool is an autogenerated conversion function.
+func Convert_v1alpha1_IPPool_To_calico_IPPool(in *IPPool, out *calico.IPPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPool_To_calico_IPPool(in, out, s)
+}
+
+func autoConvert_calico_IPPool_To_v1alpha1_IPPool(in *calico.IPPool, out *IPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = CIDR(in.CIDR)
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	out.Encapsulation = (*Pool)(unsafe.Pointer(in.Encapsulation))
+	out.Mode = (*PoolMode)(unsafe.Pointer(in.Mode))
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.NamespaceSelector = (*string)(unsafe.Pointer(in.NamespaceSelector))
+	out.AllowedUses = *(*[]IPPoolAllowedUse)(unsafe.Pointer(&in.AllowedUses))
+	return nil
+}
+
+// Convert_calico_IPPool_To_v1alpha1_IPPool is an autogenerated conversion function.
+func Convert_calico_IPPool_To_v1alpha1_IPPool(in *calico.IPPool, out *IPPool, s conversion.Scope) error {
+	return autoConvert_calico_IPPool_To_v1alpha1_IPPool(in, out, s)
+}
+
 func autoConvert_v1alpha1_IPv4_To_calico_IPv4(in *IPv4, out *calico.IPv4, s conversion.Scope) error {
 	out.Pool = (*calico.Pool)(unsafe.Pointer(in.Pool))
 	out.Mode = (*calico.PoolMode)(unsafe.Pointer(in.Mode))
 	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
 	return nil
 }
 
@@ -257,6 +703,7 @@ func autoConvert_calico_IPv4_To_v1alpha1_IPv4(in *calico.IPv4, out *IPv4, s conv
 	out.Pool = (*Pool)(unsafe.Pointer(in.Pool))
 	out.Mode = (*PoolMode)(unsafe.Pointer(in.Mode))
 	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
 	return nil
 }
 
@@ -270,6 +717,7 @@ func autoConvert_v1alpha1_IPv6_To_calico_IPv6(in *IPv6, out *calico.IPv6, s conv
 	out.Mode = (*calico.PoolMode)(unsafe.Pointer(in.Mode))
 	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
 	out.SourceNATEnabled = (*bool)(unsafe.Pointer(in.SourceNATEnabled))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
 	return nil
 }
 
@@ -283,6 +731,7 @@ func autoConvert_calico_IPv6_To_v1alpha1_IPv6(in *calico.IPv6, out *IPv6, s conv
 	out.Mode = (*PoolMode)(unsafe.Pointer(in.Mode))
 	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
 	out.SourceNATEnabled = (*bool)(unsafe.Pointer(in.SourceNATEnabled))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
 	return nil
 }
 
@@ -291,6 +740,30 @@ func Convert_calico_IPv6_To_v1alpha1_IPv6(in *calico.IPv6, out *IPv6, s conversi
 	return autoConvert_calico_IPv6_To_v1alpha1_IPv6(in, out, s)
 }
 
+func autoConvert_v1alpha1_MigrationStatus_To_calico_MigrationStatus(in *MigrationStatus, out *calico.MigrationStatus, s conversion.Scope) error {
+	out.Type = calico.MigrationType(in.Type)
+	out.Phase = calico.MigrationPhase(in.Phase)
+	out.Nodes = *(*[]calico.NodeMigrationStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_v1alpha1_MigrationStatus_To_calico_MigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MigrationStatus_To_calico_MigrationStatus(in *MigrationStatus, out *calico.MigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MigrationStatus_To_calico_MigrationStatus(in, out, s)
+}
+
+func autoConvert_calico_MigrationStatus_To_v1alpha1_MigrationStatus(in *calico.MigrationStatus, out *MigrationStatus, s conversion.Scope) error {
+	out.Type = MigrationType(in.Type)
+	out.Phase = MigrationPhase(in.Phase)
+	out.Nodes = *(*[]NodeMigrationStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_calico_MigrationStatus_To_v1alpha1_MigrationStatus is an autogenerated conversion function.
+func Convert_calico_MigrationStatus_To_v1alpha1_MigrationStatus(in *calico.MigrationStatus, out *MigrationStatus, s conversion.Scope) error {
+	return autoConvert_calico_MigrationStatus_To_v1alpha1_MigrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Multus_To_calico_Multus(in *Multus, out *calico.Multus, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.InstallCNIPlugins = (*bool)(unsafe.Pointer(in.InstallCNIPlugins))
@@ -314,6 +787,7 @@ func Convert_calico_Multus_To_v1alpha1_Multus(in *calico.Multus, out *Multus, s
 }
 
 func autoConvert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, out *calico.NetworkConfig, s conversion.Scope) error {
+	out.Profile = (*string)(unsafe.Pointer(in.Profile))
 	out.Backend = (*calico.Backend)(unsafe.Pointer(in.Backend))
 	out.IPAM = (*calico.IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPv4 = (*calico.IPv4)(unsafe.Pointer(in.IPv4))
@@ -328,9 +802,14 @@ func autoConvert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfi
 	out.IPIP = (*calico.PoolMode)(unsafe.Pointer(in.IPIP))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
 	out.WireguardEncryption = in.WireguardEncryption
+	out.Wireguard = (*calico.Wireguard)(unsafe.Pointer(in.Wireguard))
 	out.BirdExporter = (*calico.BirdExporter)(unsafe.Pointer(in.BirdExporter))
 	out.Multus = (*calico.Multus)(unsafe.Pointer(in.Multus))
 	out.ServiceLoopPrevention = (*calico.ServiceLoopPrevention)(unsafe.Pointer(in.ServiceLoopPrevention))
+	out.BGP = (*calico.BGP)(unsafe.Pointer(in.BGP))
+	out.IPPools = *(*[]calico.IPPool)(unsafe.Pointer(&in.IPPools))
+	out.Felix = (*calico.FelixConfiguration)(unsafe.Pointer(in.Felix))
+	out.WorkerPools = *(*[]calico.WorkerPool)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -340,6 +819,7 @@ func Convert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, o
 }
 
 func autoConvert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	out.Profile = (*string)(unsafe.Pointer(in.Profile))
 	out.Backend = (*Backend)(unsafe.Pointer(in.Backend))
 	out.IPAM = (*IPAM)(unsafe.Pointer(in.IPAM))
 	out.IPv4 = (*IPv4)(unsafe.Pointer(in.IPv4))
@@ -354,9 +834,14 @@ func autoConvert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.Netwo
 	out.IPIP = (*PoolMode)(unsafe.Pointer(in.IPIP))
 	out.IPAutoDetectionMethod = (*string)(unsafe.Pointer(in.IPAutoDetectionMethod))
 	out.WireguardEncryption = in.WireguardEncryption
+	out.Wireguard = (*Wireguard)(unsafe.Pointer(in.Wireguard))
 	out.BirdExporter = (*BirdExporter)(unsafe.Pointer(in.BirdExporter))
 	out.Multus = (*Multus)(unsafe.Pointer(in.Multus))
 	out.ServiceLoopPrevention = (*ServiceLoopPrevention)(unsafe.Pointer(in.ServiceLoopPrevention))
+	out.BGP = (*BGP)(unsafe.Pointer(in.BGP))
+	out.IPPools = *(*[]IPPool)(unsafe.Pointer(&in.IPPools))
+	out.Felix = (*FelixConfiguration)(unsafe.Pointer(in.Felix))
+	out.WorkerPools = *(*[]WorkerPool)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -366,6 +851,17 @@ func Convert_calico_NetworkConfig_To_v1alpha1_NetworkConfig(in *calico.NetworkCo
 }
 
 func autoConvert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, out *calico.NetworkStatus, s conversion.Scope) error {
+	out.Backend = (*calico.Backend)(unsafe.Pointer(in.Backend))
+	out.IPAM = (*string)(unsafe.Pointer(in.IPAM))
+	out.Dataplane = (*calico.Dataplane)(unsafe.Pointer(in.Dataplane))
+	out.OverlayEnabled = in.OverlayEnabled
+	out.VXLANEnabled = in.VXLANEnabled
+	out.IPv4 = (*calico.IPFamilyStatus)(unsafe.Pointer(in.IPv4))
+	out.IPv6 = (*calico.IPFamilyStatus)(unsafe.Pointer(in.IPv6))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	out.Wireguard = (*calico.WireguardStatus)(unsafe.Pointer(in.Wireguard))
+	out.Profile = (*calico.ProfileStatus)(unsafe.Pointer(in.Profile))
+	out.Migration = (*calico.MigrationStatus)(unsafe.Pointer(in.Migration))
 	return nil
 }
 
@@ -375,6 +871,17 @@ func Convert_v1alpha1_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, o
 }
 
 func autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.Backend = (*Backend)(unsafe.Pointer(in.Backend))
+	out.IPAM = (*string)(unsafe.Pointer(in.IPAM))
+	out.Dataplane = (*Dataplane)(unsafe.Pointer(in.Dataplane))
+	out.OverlayEnabled = in.OverlayEnabled
+	out.VXLANEnabled = in.VXLANEnabled
+	out.IPv4 = (*IPFamilyStatus)(unsafe.Pointer(in.IPv4))
+	out.IPv6 = (*IPFamilyStatus)(unsafe.Pointer(in.IPv6))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	out.Wireguard = (*WireguardStatus)(unsafe.Pointer(in.Wireguard))
+	out.Profile = (*ProfileStatus)(unsafe.Pointer(in.Profile))
+	out.Migration = (*MigrationStatus)(unsafe.Pointer(in.Migration))
 	return nil
 }
 
@@ -383,6 +890,32 @@ func Convert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in *calico.NetworkSt
 	return autoConvert_calico_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeMigrationStatus_To_calico_NodeMigrationStatus(in *NodeMigrationStatus, out *calico.NodeMigrationStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.PreviousWireguardPublicKeys = *(*[]string)(unsafe.Pointer(&in.PreviousWireguardPublicKeys))
+	return nil
+}
+
+// Convert_v1alpha1_NodeMigrationStatus_To_calico_NodeMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NodeMigrationStatus_To_calico_NodeMigrationStatus(in *NodeMigrationStatus, out *calico.NodeMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeMigrationStatus_To_calico_NodeMigrationStatus(in, out, s)
+}
+
+func autoConvert_calico_NodeMigrationStatus_To_v1alpha1_NodeMigrationStatus(in *calico.NodeMigrationStatus, out *NodeMigrationStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.PreviousWireguardPublicKeys = *(*[]string)(unsafe.Pointer(&in.PreviousWireguardPublicKeys))
+	return nil
+}
+
+// Convert_calico_NodeMigrationStatus_To_v1alpha1_NodeMigrationStatus is an autogenerated conversion function.
+func Convert_calico_NodeMigrationStatus_To_v1alpha1_NodeMigrationStatus(in *calico.NodeMigrationStatus, out *NodeMigrationStatus, s conversion.Scope) error {
+	return autoConvert_calico_NodeMigrationStatus_To_v1alpha1_NodeMigrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Overlay_To_calico_Overlay(in *Overlay, out *calico.Overlay, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.CreatePodRoutes = (*bool)(unsafe.Pointer(in.CreatePodRoutes))
@@ -405,6 +938,28 @@ func Convert_calico_Overlay_To_v1alpha1_Overlay(in *calico.Overlay, out *Overlay
 	return autoConvert_calico_Overlay_To_v1alpha1_Overlay(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProfileStatus_To_calico_ProfileStatus(in *ProfileStatus, out *calico.ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha1_ProfileStatus_To_calico_ProfileStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ProfileStatus_To_calico_ProfileStatus(in *ProfileStatus, out *calico.ProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProfileStatus_To_calico_ProfileStatus(in, out, s)
+}
+
+func autoConvert_calico_ProfileStatus_To_v1alpha1_ProfileStatus(in *calico.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_calico_ProfileStatus_To_v1alpha1_ProfileStatus is an autogenerated conversion function.
+func Convert_calico_ProfileStatus_To_v1alpha1_ProfileStatus(in *calico.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	return autoConvert_calico_ProfileStatus_To_v1alpha1_ProfileStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(in *SnatToUpstreamDNS, out *calico.SnatToUpstreamDNS, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -464,3 +1019,87 @@ func autoConvert_calico_VXLAN_To_v1alpha1_VXLAN(in *calico.VXLAN, out *VXLAN, s
 func Convert_calico_VXLAN_To_v1alpha1_VXLAN(in *calico.VXLAN, out *VXLAN, s conversion.Scope) error {
 	return autoConvert_calico_VXLAN_To_v1alpha1_VXLAN(in, out, s)
 }
+
+func autoConvert_v1alpha1_Wireguard_To_calico_Wireguard(in *Wireguard, out *calico.Wireguard, s conversion.Scope) error {
+	out.HostEncryptionEnabled = (*bool)(unsafe.Pointer(in.HostEncryptionEnabled))
+	out.RoutingRulePriority = (*int32)(unsafe.Pointer(in.RoutingRulePriority))
+	out.InterfaceName = (*string)(unsafe.Pointer(in.InterfaceName))
+	out.InterfaceNameV6 = (*string)(unsafe.Pointer(in.InterfaceNameV6))
+	out.ListeningPort = (*int32)(unsafe.Pointer(in.ListeningPort))
+	out.ListeningPortV6 = (*int32)(unsafe.Pointer(in.ListeningPortV6))
+	return nil
+}
+
+// Convert_v1alpha1_Wireguard_To_calico_Wireguard is an autogenerated conversion function.
+func Convert_v1alpha1_Wireguard_To_calico_Wireguard(in *Wireguard, out *calico.Wireguard, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Wireguard_To_calico_Wireguard(in, out, s)
+}
+
+func autoConvert_calico_Wireguard_To_v1alpha1_Wireguard(in *calico.Wireguard, out *Wireguard, s conversion.Scope) error {
+	out.HostEncryptionEnabled = (*bool)(unsafe.Pointer(in.HostEncryptionEnabled))
+	out.RoutingRulePriority = (*int32)(unsafe.Pointer(in.RoutingRulePriority))
+	out.InterfaceName = (*string)(unsafe.Pointer(in.InterfaceName))
+	out.InterfaceNameV6 = (*string)(unsafe.Pointer(in.InterfaceNameV6))
+	out.ListeningPort = (*int32)(unsafe.Pointer(in.ListeningPort))
+	out.ListeningPortV6 = (*int32)(unsafe.Pointer(in.ListeningPortV6))
+	return nil
+}
+
+// Convert_calico_Wireguard_To_v1alpha1_Wireguard is an autogenerated conversion function.
+func Convert_calico_Wireguard_To_v1alpha1_Wireguard(in *calico.Wireguard, out *Wireguard, s conversion.Scope) error {
+	return autoConvert_calico_Wireguard_To_v1alpha1_Wireguard(in, out, s)
+}
+
+func autoConvert_v1alpha1_WireguardStatus_To_calico_WireguardStatus(in *WireguardStatus, out *calico.WireguardStatus, s conversion.Scope) error {
+	out.EncryptedNodes = in.EncryptedNodes
+	out.PendingNodes = in.PendingNodes
+	out.UnsupportedNodes = *(*[]string)(unsafe.Pointer(&in.UnsupportedNodes))
+	out.KeyRotation = (*string)(unsafe.Pointer(in.KeyRotation))
+	return nil
+}
+
+// Convert_v1alpha1_WireguardStatus_To_calico_WireguardStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WireguardStatus_To_calico_WireguardStatus(in *WireguardStatus, out *calico.WireguardStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WireguardStatus_To_calico_WireguardStatus(in, out, s)
+}
+
+func autoConvert_calico_WireguardStatus_To_v1alpha1_WireguardStatus(in *calico.WireguardStatus, out *WireguardStatus, s conversion.Scope) error {
+	out.EncryptedNodes = in.EncryptedNodes
+	out.PendingNodes = in.PendingNodes
+	out.UnsupportedNodes = *(*[]string)(unsafe.Pointer(&in.UnsupportedNodes))
+	out.KeyRotation = (*string)(unsafe.Pointer(in.KeyRotation))
+	return nil
+}
+
+// Convert_calico_WireguardStatus_To_v1alpha1_WireguardStatus is an autogenerated conversion function.
+func Convert_calico_WireguardStatus_To_v1alpha1_WireguardStatus(in *calico.WireguardStatus, out *WireguardStatus, s conversion.Scope) error {
+	return autoConvert_calico_WireguardStatus_To_v1alpha1_WireguardStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPool_To_calico_WorkerPool(in *WorkerPool, out *calico.WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.EbpfDataplane = (*calico.EbpfDataplane)(unsafe.Pointer(in.EbpfDataplane))
+	out.IPv4AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv4AutoDetectionMethod))
+	out.IPv6AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv6AutoDetectionMethod))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPool_To_calico_WorkerPool is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPool_To_calico_WorkerPool(in *WorkerPool, out *calico.WorkerPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPool_To_calico_WorkerPool(in, out, s)
+}
+
+func autoConvert_calico_WorkerPool_To_v1alpha1_WorkerPool(in *calico.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.EbpfDataplane = (*EbpfDataplane)(unsafe.Pointer(in.EbpfDataplane))
+	out.IPv4AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv4AutoDetectionMethod))
+	out.IPv6AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv6AutoDetectionMethod))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	return nil
+}
+
+// Convert_calico_WorkerPool_To_v1alpha1_WorkerPool is an autogenerated conversion function.
+func Convert_calico_WorkerPool_To_v1alpha1_WorkerPool(in *calico.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	return autoConvert_calico_WorkerPool_To_v1alpha1_WorkerPool(in, out, s)
+}
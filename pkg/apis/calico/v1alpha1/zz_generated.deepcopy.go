@@ -10,8 +10,9 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -36,6 +37,132 @@ func (in *AutoScaling) DeepCopy() *AutoScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGP) DeepCopyInto(out *BGP) {
+	*out = *in
+	if in.ASNumber != nil {
+		in, out := &in.ASNumber, &out.ASNumber
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NodeToNodeMeshEnabled != nil {
+		in, out := &in.NodeToNodeMeshEnabled, &out.NodeToNodeMeshEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]BGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteReflector != nil {
+		in, out := &in.RouteReflector, &out.RouteReflector
+		*out = new(BGPRouteReflector)
+		**out = **in
+	}
+	if in.ServiceClusterIPs != nil {
+		in, out := &in.ServiceClusterIPs, &out.ServiceClusterIPs
+		*out = new(BGPServiceClusterIPs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceExternalIPs != nil {
+		in, out := &in.ServiceExternalIPs, &out.ServiceExternalIPs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceLoadBalancerIPs != nil {
+		in, out := &in.ServiceLoadBalancerIPs, &out.ServiceLoadBalancerIPs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGP.
+func (in *BGP) DeepCopy() *BGP {
+	if in == nil {
+		return nil
+	}
+	out := new(BGP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
+	*out = *in
+	if in.PeerIP != nil {
+		in, out := &in.PeerIP, &out.PeerIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ASNumber != nil {
+		in, out := &in.ASNumber, &out.ASNumber
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(string)
+		**out = **in
+	}
+	if in.PeerSelector != nil {
+		in, out := &in.PeerSelector, &out.PeerSelector
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeer.
+func (in *BGPPeer) DeepCopy() *BGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPRouteReflector) DeepCopyInto(out *BGPRouteReflector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRouteReflector.
+func (in *BGPRouteReflector) DeepCopy() *BGPRouteReflector {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPRouteReflector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPServiceClusterIPs) DeepCopyInto(out *BGPServiceClusterIPs) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPServiceClusterIPs.
+func (in *BGPServiceClusterIPs) DeepCopy() *BGPServiceClusterIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPServiceClusterIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BirdExporter) DeepCopyInto(out *BirdExporter) {
 	*out = *in
@@ -68,6 +195,158 @@ func (in *EbpfDataplane) DeepCopy() *EbpfDataplane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixConfiguration) DeepCopyInto(out *FelixConfiguration) {
+	*out = *in
+	if in.LogSeverityScreen != nil {
+		in, out := &in.LogSeverityScreen, &out.LogSeverityScreen
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteRefreshInterval != nil {
+		in, out := &in.RouteRefreshInterval, &out.RouteRefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IptablesRefreshInterval != nil {
+		in, out := &in.IptablesRefreshInterval, &out.IptablesRefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.NftablesRefreshInterval != nil {
+		in, out := &in.NftablesRefreshInterval, &out.NftablesRefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BPFConntrackTimeouts != nil {
+		in, out := &in.BPFConntrackTimeouts, &out.BPFConntrackTimeouts
+		*out = new(FelixConntrackTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailsafeInboundHostPorts != nil {
+		in, out := &in.FailsafeInboundHostPorts, &out.FailsafeInboundHostPorts
+		*out = make([]FelixProtoPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailsafeOutboundHostPorts != nil {
+		in, out := &in.FailsafeOutboundHostPorts, &out.FailsafeOutboundHostPorts
+		*out = make([]FelixProtoPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NATPortRange != nil {
+		in, out := &in.NATPortRange, &out.NATPortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlowLogsFlushInterval != nil {
+		in, out := &in.FlowLogsFlushInterval, &out.FlowLogsFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FlowLogsPolicyEvaluationMode != nil {
+		in, out := &in.FlowLogsPolicyEvaluationMode, &out.FlowLogsPolicyEvaluationMode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixConfiguration.
+func (in *FelixConfiguration) DeepCopy() *FelixConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixConntrackTimeouts) DeepCopyInto(out *FelixConntrackTimeouts) {
+	*out = *in
+	if in.CreationGracePeriod != nil {
+		in, out := &in.CreationGracePeriod, &out.CreationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TCPSynSent != nil {
+		in, out := &in.TCPSynSent, &out.TCPSynSent
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TCPEstablished != nil {
+		in, out := &in.TCPEstablished, &out.TCPEstablished
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TCPFinsSeen != nil {
+		in, out := &in.TCPFinsSeen, &out.TCPFinsSeen
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TCPResetSeen != nil {
+		in, out := &in.TCPResetSeen, &out.TCPResetSeen
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UDPTimeout != nil {
+		in, out := &in.UDPTimeout, &out.UDPTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GenericTimeout != nil {
+		in, out := &in.GenericTimeout, &out.GenericTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ICMPTimeout != nil {
+		in, out := &in.ICMPTimeout, &out.ICMPTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixConntrackTimeouts.
+func (in *FelixConntrackTimeouts) DeepCopy() *FelixConntrackTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixConntrackTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FelixProtoPort) DeepCopyInto(out *FelixProtoPort) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Net != nil {
+		in, out := &in.Net, &out.Net
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixProtoPort.
+func (in *FelixProtoPort) DeepCopy() *FelixProtoPort {
+	if in == nil {
+		return nil
+	}
+	out := new(FelixProtoPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAM) DeepCopyInto(out *IPAM) {
 	*out = *in
@@ -76,6 +355,11 @@ func (in *IPAM) DeepCopyInto(out *IPAM) {
 		*out = new(CIDR)
 		**out = **in
 	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(IPAMConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -89,6 +373,109 @@ func (in *IPAM) DeepCopy() *IPAM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMConfig) DeepCopyInto(out *IPAMConfig) {
+	*out = *in
+	if in.StrictAffinity != nil {
+		in, out := &in.StrictAffinity, &out.StrictAffinity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxBlocksPerHost != nil {
+		in, out := &in.MaxBlocksPerHost, &out.MaxBlocksPerHost
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoAllocateBlocks != nil {
+		in, out := &in.AutoAllocateBlocks, &out.AutoAllocateBlocks
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMConfig.
+func (in *IPAMConfig) DeepCopy() *IPAMConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFamilyStatus) DeepCopyInto(out *IPFamilyStatus) {
+	*out = *in
+	if in.AutoDetectionMethod != nil {
+		in, out := &in.AutoDetectionMethod, &out.AutoDetectionMethod
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFamilyStatus.
+func (in *IPFamilyStatus) DeepCopy() *IPFamilyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFamilyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+	if in.BlockSize != nil {
+		in, out := &in.BlockSize, &out.BlockSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Encapsulation != nil {
+		in, out := &in.Encapsulation, &out.Encapsulation
+		*out = new(Pool)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(PoolMode)
+		**out = **in
+	}
+	if in.NATOutgoing != nil {
+		in, out := &in.NATOutgoing, &out.NATOutgoing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedUses != nil {
+		in, out := &in.AllowedUses, &out.AllowedUses
+		*out = make([]IPPoolAllowedUse, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPv4) DeepCopyInto(out *IPv4) {
 	*out = *in
@@ -107,6 +494,11 @@ func (in *IPv4) DeepCopyInto(out *IPv4) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BlockSize != nil {
+		in, out := &in.BlockSize, &out.BlockSize
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -143,6 +535,11 @@ func (in *IPv6) DeepCopyInto(out *IPv6) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BlockSize != nil {
+		in, out := &in.BlockSize, &out.BlockSize
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -156,6 +553,29 @@ func (in *IPv6) DeepCopy() *IPv6 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeMigrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationStatus.
+func (in *MigrationStatus) DeepCopy() *MigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Multus) DeepCopyInto(out *Multus) {
 	*out = *in
@@ -181,6 +601,11 @@ func (in *Multus) DeepCopy() *Multus {
 func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(string)
+		**out = **in
+	}
 	if in.Backend != nil {
 		in, out := &in.Backend, &out.Backend
 		*out = new(Backend)
@@ -246,6 +671,11 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Wireguard != nil {
+		in, out := &in.Wireguard, &out.Wireguard
+		*out = new(Wireguard)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BirdExporter != nil {
 		in, out := &in.BirdExporter, &out.BirdExporter
 		*out = new(BirdExporter)
@@ -261,6 +691,30 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 		*out = new(ServiceLoopPrevention)
 		**out = **in
 	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(BGP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Felix != nil {
+		in, out := &in.Felix, &out.Felix
+		*out = new(FelixConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -286,6 +740,51 @@ func (in *NetworkConfig) DeepCopyObject() runtime.Object {
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(Backend)
+		**out = **in
+	}
+	if in.IPAM != nil {
+		in, out := &in.IPAM, &out.IPAM
+		*out = new(string)
+		**out = **in
+	}
+	if in.Dataplane != nil {
+		in, out := &in.Dataplane, &out.Dataplane
+		*out = new(Dataplane)
+		**out = **in
+	}
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(IPFamilyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPFamilyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VethMTU != nil {
+		in, out := &in.VethMTU, &out.VethMTU
+		*out = new(string)
+		**out = **in
+	}
+	if in.Wireguard != nil {
+		in, out := &in.Wireguard, &out.Wireguard
+		*out = new(WireguardStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(ProfileStatus)
+		**out = **in
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -307,6 +806,32 @@ func (in *NetworkStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMigrationStatus) DeepCopyInto(out *NodeMigrationStatus) {
+	*out = *in
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreviousWireguardPublicKeys != nil {
+		in, out := &in.PreviousWireguardPublicKeys, &out.PreviousWireguardPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMigrationStatus.
+func (in *NodeMigrationStatus) DeepCopy() *NodeMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Overlay) DeepCopyInto(out *Overlay) {
 	*out = *in
@@ -328,15 +853,31 @@ func (in *Overlay) DeepCopy() *Overlay {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileStatus) DeepCopyInto(out *ProfileStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStatus.
+func (in *ProfileStatus) DeepCopy() *ProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
 	if in.Node != nil {
 		in, out := &in.Node, &out.Node
-		*out = new(v1.ResourceList)
+		*out = new(corev1.ResourceList)
 		if **in != nil {
 			in, out := *in, *out
-			*out = make(map[v1.ResourceName]resource.Quantity, len(*in))
+			*out = make(map[corev1.ResourceName]resource.Quantity, len(*in))
 			for key, val := range *in {
 				(*out)[key] = val.DeepCopy()
 			}
@@ -344,10 +885,10 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 	}
 	if in.Typha != nil {
 		in, out := &in.Typha, &out.Typha
-		*out = new(v1.ResourceList)
+		*out = new(corev1.ResourceList)
 		if **in != nil {
 			in, out := *in, *out
-			*out = make(map[v1.ResourceName]resource.Quantity, len(*in))
+			*out = make(map[corev1.ResourceName]resource.Quantity, len(*in))
 			for key, val := range *in {
 				(*out)[key] = val.DeepCopy()
 			}
@@ -413,3 +954,111 @@ func (in *VXLAN) DeepCopy() *VXLAN {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Wireguard) DeepCopyInto(out *Wireguard) {
+	*out = *in
+	if in.HostEncryptionEnabled != nil {
+		in, out := &in.HostEncryptionEnabled, &out.HostEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RoutingRulePriority != nil {
+		in, out := &in.RoutingRulePriority, &out.RoutingRulePriority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InterfaceName != nil {
+		in, out := &in.InterfaceName, &out.InterfaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.InterfaceNameV6 != nil {
+		in, out := &in.InterfaceNameV6, &out.InterfaceNameV6
+		*out = new(string)
+		**out = **in
+	}
+	if in.ListeningPort != nil {
+		in, out := &in.ListeningPort, &out.ListeningPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ListeningPortV6 != nil {
+		in, out := &in.ListeningPortV6, &out.ListeningPortV6
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wireguard.
+func (in *Wireguard) DeepCopy() *Wireguard {
+	if in == nil {
+		return nil
+	}
+	out := new(Wireguard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireguardStatus) DeepCopyInto(out *WireguardStatus) {
+	*out = *in
+	if in.UnsupportedNodes != nil {
+		in, out := &in.UnsupportedNodes, &out.UnsupportedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyRotation != nil {
+		in, out := &in.KeyRotation, &out.KeyRotation
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireguardStatus.
+func (in *WireguardStatus) DeepCopy() *WireguardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WireguardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
+	*out = *in
+	if in.EbpfDataplane != nil {
+		in, out := &in.EbpfDataplane, &out.EbpfDataplane
+		*out = new(EbpfDataplane)
+		**out = **in
+	}
+	if in.IPv4AutoDetectionMethod != nil {
+		in, out := &in.IPv4AutoDetectionMethod, &out.IPv4AutoDetectionMethod
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6AutoDetectionMethod != nil {
+		in, out := &in.IPv6AutoDetectionMethod, &out.IPv6AutoDetectionMethod
+		*out = new(string)
+		**out = **in
+	}
+	if in.VethMTU != nil {
+		in, out := &in.VethMTU, &out.VethMTU
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPool.
+func (in *WorkerPool) DeepCopy() *WorkerPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPool)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,5 +17,16 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&NetworkConfig{}, func(obj interface{}) { SetObjectDefaults_NetworkConfig(obj.(*NetworkConfig)) })
 	return nil
 }
+
+func SetObjectDefaults_NetworkConfig(in *NetworkConfig) {
+	SetDefaults_NetworkConfig(in)
+	if in.IPv6 != nil {
+		SetDefaults_IPv6(in.IPv6)
+	}
+	if in.AutoScaling != nil {
+		SetDefaults_AutoScaling(in.AutoScaling)
+	}
+}
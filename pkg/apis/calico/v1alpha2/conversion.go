@@ -0,0 +1,347 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+)
+
+// Convert_v1alpha2_NetworkConfig_To_calico_NetworkConfig converts a v1alpha2 network config into an internal one. The
+// encapsulation of the default IP pools is additionally expressed by the overlay and vxlan settings of the internal
+// network config, which the migrations of the pod network are based on.
+func Convert_v1alpha2_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, out *calico.NetworkConfig, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_NetworkConfig_To_calico_NetworkConfig(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Dataplane != nil {
+		ebpfDataplane, err := convertDataplane(*in.Dataplane)
+		if err != nil {
+			return err
+		}
+		out.EbpfDataplane = ebpfDataplane
+	}
+
+	if in.Wireguard != nil {
+		out.WireguardEncryption = in.Wireguard.Enabled
+	}
+
+	for _, encapsulation := range []*Encapsulation{ipv4Encapsulation(in), ipv6Encapsulation(in)} {
+		if encapsulation == nil {
+			continue
+		}
+		if out.Overlay == nil {
+			out.Overlay = &calico.Overlay{}
+		}
+		out.Overlay.Enabled = out.Overlay.Enabled || *encapsulation != EncapsulationNone
+	}
+	if out.Overlay != nil && !out.Overlay.Enabled && ptr.Deref(in.Backend, Bird) != None {
+		out.Overlay.CreatePodRoutes = ptr.To(true)
+	}
+	if encapsulation := ipv4Encapsulation(in); encapsulation != nil && (*encapsulation == EncapsulationVXLAN || *encapsulation == EncapsulationVXLANCrossSubnet) {
+		out.VXLAN = &calico.VXLAN{Enabled: true}
+	}
+
+	return nil
+}
+
+// Convert_calico_NetworkConfig_To_v1alpha2_NetworkConfig converts an internal network config into a v1alpha2 one. The
+// overlay settings and the deprecated fields of v1alpha1 are folded into the encapsulation of the IP families which are
+// configured. Settings which are set explicitly win over the ones derived from the overlay settings.
+func Convert_calico_NetworkConfig_To_v1alpha2_NetworkConfig(in *calico.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	if err := autoConvert_calico_NetworkConfig_To_v1alpha2_NetworkConfig(in, out, s); err != nil {
+		return err
+	}
+
+	if in.EbpfDataplane != nil {
+		out.Dataplane = ptr.To(DataplaneIPTables)
+		if in.EbpfDataplane.Enabled {
+			out.Dataplane = ptr.To(DataplaneEBPF)
+		}
+	}
+
+	if in.WireguardEncryption {
+		if out.Wireguard == nil {
+			out.Wireguard = &Wireguard{}
+		}
+		out.Wireguard.Enabled = true
+	}
+
+	if in.IPv4 != nil {
+		pool, mode := in.IPv4.Pool, in.IPv4.Mode
+		if pool == nil && in.VXLAN != nil && in.VXLAN.Enabled {
+			pool = ptr.To(calico.PoolVXLan)
+		}
+		if mode == nil && in.Overlay != nil && !in.Overlay.Enabled {
+			mode = ptr.To(calico.Never)
+		}
+		out.IPv4.Encapsulation = encapsulationOf(pool, mode, calico.PoolIPIP)
+	} else if in.IPIP != nil {
+		// the deprecated fields only take effect if the IPv4 settings are not set
+		out.IPv4 = &IPv4{
+			Encapsulation:       encapsulationOf(nil, in.IPIP, calico.PoolIPIP),
+			AutoDetectionMethod: in.IPAutoDetectionMethod,
+		}
+	}
+
+	if in.IPv6 != nil {
+		// The IPv6 pod traffic is only encapsulated if the mode is set explicitly.
+		mode := in.IPv6.Mode
+		if mode == nil && in.Overlay != nil {
+			mode = ptr.To(calico.Never)
+		}
+		out.IPv6.Encapsulation = encapsulationOf(in.IPv6.Pool, mode, calico.PoolVXLan)
+	}
+
+	if out.Backend == nil && in.Overlay != nil && !in.Overlay.Enabled && !ptr.Deref(in.Overlay.CreatePodRoutes, false) {
+		out.Backend = ptr.To(None)
+	}
+
+	return nil
+}
+
+// Convert_v1alpha2_IPv4_To_calico_IPv4 converts v1alpha2 IPv4 settings into internal ones.
+func Convert_v1alpha2_IPv4_To_calico_IPv4(in *IPv4, out *calico.IPv4, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPv4_To_calico_IPv4(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Encapsulation != nil {
+		pool, mode, err := convertEncapsulation(*in.Encapsulation)
+		if err != nil {
+			return err
+		}
+		out.Pool, out.Mode = pool, &mode
+	}
+	return nil
+}
+
+// Convert_calico_IPv4_To_v1alpha2_IPv4 converts internal IPv4 settings into v1alpha2 ones.
+func Convert_calico_IPv4_To_v1alpha2_IPv4(in *calico.IPv4, out *IPv4, s conversion.Scope) error {
+	if err := autoConvert_calico_IPv4_To_v1alpha2_IPv4(in, out, s); err != nil {
+		return err
+	}
+
+	out.Encapsulation = encapsulationOf(in.Pool, in.Mode, calico.PoolIPIP)
+	return nil
+}
+
+// Convert_v1alpha2_IPv6_To_calico_IPv6 converts v1alpha2 IPv6 settings into internal ones.
+func Convert_v1alpha2_IPv6_To_calico_IPv6(in *IPv6, out *calico.IPv6, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPv6_To_calico_IPv6(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Encapsulation != nil {
+		pool, mode, err := convertEncapsulation(*in.Encapsulation)
+		if err != nil {
+			return err
+		}
+		out.Pool, out.Mode = pool, &mode
+	}
+	return nil
+}
+
+// Convert_calico_IPv6_To_v1alpha2_IPv6 converts internal IPv6 settings into v1alpha2 ones.
+func Convert_calico_IPv6_To_v1alpha2_IPv6(in *calico.IPv6, out *IPv6, s conversion.Scope) error {
+	if err := autoConvert_calico_IPv6_To_v1alpha2_IPv6(in, out, s); err != nil {
+		return err
+	}
+
+	out.Encapsulation = encapsulationOf(in.Pool, in.Mode, calico.PoolVXLan)
+	return nil
+}
+
+// Convert_v1alpha2_Wireguard_To_calico_Wireguard converts v1alpha2 WireGuard settings into internal ones. Whether the
+// encryption is enabled is converted along with the network config.
+func Convert_v1alpha2_Wireguard_To_calico_Wireguard(in *Wireguard, out *calico.Wireguard, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Wireguard_To_calico_Wireguard(in, out, s)
+}
+
+// Convert_v1alpha2_IPPool_To_calico_IPPool converts a v1alpha2 IP pool into an internal one.
+func Convert_v1alpha2_IPPool_To_calico_IPPool(in *IPPool, out *calico.IPPool, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPPool_To_calico_IPPool(in, out, s); err != nil {
+		return err
+	}
+
+	out.Encapsulation, out.Mode = nil, nil
+	if in.Encapsulation != nil {
+		pool, mode, err := convertEncapsulation(*in.Encapsulation)
+		if err != nil {
+			return err
+		}
+		out.Encapsulation, out.Mode = pool, &mode
+	}
+	return nil
+}
+
+// Convert_calico_IPPool_To_v1alpha2_IPPool converts an internal IP pool into a v1alpha2 one.
+func Convert_calico_IPPool_To_v1alpha2_IPPool(in *calico.IPPool, out *IPPool, s conversion.Scope) error {
+	if err := autoConvert_calico_IPPool_To_v1alpha2_IPPool(in, out, s); err != nil {
+		return err
+	}
+
+	defaultPool := calico.PoolIPIP
+	if strings.Contains(string(in.CIDR), ":") {
+		defaultPool = calico.PoolVXLan
+	}
+	out.Encapsulation = encapsulationOf(in.Encapsulation, in.Mode, defaultPool)
+	return nil
+}
+
+// Convert_v1alpha2_WorkerPool_To_calico_WorkerPool converts v1alpha2 worker pool settings into internal ones.
+func Convert_v1alpha2_WorkerPool_To_calico_WorkerPool(in *WorkerPool, out *calico.WorkerPool, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_WorkerPool_To_calico_WorkerPool(in, out, s); err != nil {
+		return err
+	}
+
+	if in.Dataplane != nil {
+		ebpfDataplane, err := convertDataplane(*in.Dataplane)
+		if err != nil {
+			return err
+		}
+		out.EbpfDataplane = ebpfDataplane
+	}
+	return nil
+}
+
+// Convert_calico_WorkerPool_To_v1alpha2_WorkerPool converts internal worker pool settings into v1alpha2 ones.
+func Convert_calico_WorkerPool_To_v1alpha2_WorkerPool(in *calico.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	if err := autoConvert_calico_WorkerPool_To_v1alpha2_WorkerPool(in, out, s); err != nil {
+		return err
+	}
+
+	if in.EbpfDataplane != nil {
+		out.Dataplane = ptr.To(DataplaneIPTables)
+		if in.EbpfDataplane.Enabled {
+			out.Dataplane = ptr.To(DataplaneEBPF)
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NetworkStatus_To_calico_NetworkStatus converts a v1alpha2 network status into an internal one.
+func Convert_v1alpha2_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, out *calico.NetworkStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_NetworkStatus_To_calico_NetworkStatus(in, out, s); err != nil {
+		return err
+	}
+
+	for _, family := range []*IPFamilyStatus{in.IPv4, in.IPv6} {
+		if family != nil && family.Encapsulation != EncapsulationNone {
+			out.OverlayEnabled = true
+			out.VXLANEnabled = out.VXLANEnabled || family.Encapsulation == EncapsulationVXLAN || family.Encapsulation == EncapsulationVXLANCrossSubnet
+		}
+	}
+	return nil
+}
+
+// Convert_calico_NetworkStatus_To_v1alpha2_NetworkStatus converts an internal network status into a v1alpha2 one.
+// Whether the overlay and vxlan are enabled is expressed by the encapsulation of the IP families.
+func Convert_calico_NetworkStatus_To_v1alpha2_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_calico_NetworkStatus_To_v1alpha2_NetworkStatus(in, out, s)
+}
+
+// Convert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus converts a v1alpha2 IP family status into an internal one.
+func Convert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(in *IPFamilyStatus, out *calico.IPFamilyStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(in, out, s); err != nil {
+		return err
+	}
+
+	pool, mode, err := convertEncapsulation(in.Encapsulation)
+	if err != nil {
+		return err
+	}
+	out.Pool, out.Mode = ptr.Deref(pool, ""), mode
+	return nil
+}
+
+// Convert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus converts an internal IP family status into a v1alpha2 one.
+func Convert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(in *calico.IPFamilyStatus, out *IPFamilyStatus, s conversion.Scope) error {
+	if err := autoConvert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(in, out, s); err != nil {
+		return err
+	}
+
+	out.Encapsulation = *encapsulationOf(&in.Pool, &in.Mode, calico.PoolIPIP)
+	return nil
+}
+
+// ipv4Encapsulation returns the encapsulation of the default IPv4 pool of the given network config, if it is set.
+func ipv4Encapsulation(networkConfig *NetworkConfig) *Encapsulation {
+	if networkConfig.IPv4 == nil {
+		return nil
+	}
+	return networkConfig.IPv4.Encapsulation
+}
+
+// ipv6Encapsulation returns the encapsulation of the default IPv6 pool of the given network config, if it is set.
+func ipv6Encapsulation(networkConfig *NetworkConfig) *Encapsulation {
+	if networkConfig.IPv6 == nil {
+		return nil
+	}
+	return networkConfig.IPv6.Encapsulation
+}
+
+// convertDataplane converts the given dataplane into the eBPF dataplane settings of the internal network config.
+func convertDataplane(dataplane Dataplane) (*calico.EbpfDataplane, error) {
+	switch dataplane {
+	case DataplaneIPTables:
+		return &calico.EbpfDataplane{Enabled: false}, nil
+	case DataplaneEBPF:
+		return &calico.EbpfDataplane{Enabled: true}, nil
+	case DataplaneNFTables:
+		return nil, fmt.Errorf("unsupported value for dataplane: %s, the nftables dataplane is used automatically if kube-proxy runs in nftables mode", dataplane)
+	default:
+		return nil, fmt.Errorf("unsupported value for dataplane: %s", dataplane)
+	}
+}
+
+// convertEncapsulation converts the given encapsulation into the pool type and mode of the internal network config.
+// The pool type is not set if the traffic is not encapsulated.
+func convertEncapsulation(encapsulation Encapsulation) (*calico.Pool, calico.PoolMode, error) {
+	switch encapsulation {
+	case EncapsulationIPIP:
+		return ptr.To(calico.PoolIPIP), calico.Always, nil
+	case EncapsulationIPIPCrossSubnet:
+		return ptr.To(calico.PoolIPIP), calico.CrossSubnet, nil
+	case EncapsulationVXLAN:
+		return ptr.To(calico.PoolVXLan), calico.Always, nil
+	case EncapsulationVXLANCrossSubnet:
+		return ptr.To(calico.PoolVXLan), calico.CrossSubnet, nil
+	case EncapsulationNone:
+		return nil, calico.Never, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported value for encapsulation: %s", encapsulation)
+	}
+}
+
+// encapsulationOf returns the encapsulation for the given pool type and mode of the internal network config. It falls
+// back to the given default pool type and the Always mode. It returns nil if neither the pool type nor the mode is set.
+func encapsulationOf(pool *calico.Pool, mode *calico.PoolMode, defaultPool calico.Pool) *Encapsulation {
+	if pool == nil && mode == nil {
+		return nil
+	}
+
+	vxlan := ptr.Deref(pool, defaultPool) == calico.PoolVXLan
+	switch ptr.Deref(mode, calico.Always) {
+	case calico.Never, calico.Off:
+		return ptr.To(EncapsulationNone)
+	case calico.CrossSubnet:
+		if vxlan {
+			return ptr.To(EncapsulationVXLANCrossSubnet)
+		}
+		return ptr.To(EncapsulationIPIPCrossSubnet)
+	default:
+		if vxlan {
+			return ptr.To(EncapsulationVXLAN)
+		}
+		return ptr.To(EncapsulationIPIP)
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/install"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	. "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha2"
+)
+
+var _ = Describe("Conversion", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		install.Install(scheme)
+	})
+
+	Describe("#Convert_v1alpha2_NetworkConfig_To_calico_NetworkConfig", func() {
+		DescribeTable("should derive the pool, mode and overlay settings from the encapsulation",
+			func(encapsulation Encapsulation, pool *calico.Pool, mode calico.PoolMode, overlay *calico.Overlay, vxlan *calico.VXLAN) {
+				out := &calico.NetworkConfig{}
+				Expect(scheme.Convert(&NetworkConfig{IPv4: &IPv4{Encapsulation: ptr.To(encapsulation)}}, out, nil)).To(Succeed())
+
+				Expect(out.IPv4.Pool).To(Equal(pool))
+				Expect(out.IPv4.Mode).To(Equal(ptr.To(mode)))
+				Expect(out.Overlay).To(Equal(overlay))
+				Expect(out.VXLAN).To(Equal(vxlan))
+			},
+
+			Entry("IPIP", EncapsulationIPIP, ptr.To(calico.PoolIPIP), calico.Always, &calico.Overlay{Enabled: true}, nil),
+			Entry("IPIPCrossSubnet", EncapsulationIPIPCrossSubnet, ptr.To(calico.PoolIPIP), calico.CrossSubnet, &calico.Overlay{Enabled: true}, nil),
+			Entry("VXLAN", EncapsulationVXLAN, ptr.To(calico.PoolVXLan), calico.Always, &calico.Overlay{Enabled: true}, &calico.VXLAN{Enabled: true}),
+			Entry("None", EncapsulationNone, nil, calico.Never, &calico.Overlay{Enabled: false, CreatePodRoutes: ptr.To(true)}, nil),
+		)
+
+		It("should not create pod routes without encapsulation if the backend is none", func() {
+			out := &calico.NetworkConfig{}
+			Expect(scheme.Convert(&NetworkConfig{Backend: ptr.To(None), IPv4: &IPv4{Encapsulation: ptr.To(EncapsulationNone)}}, out, nil)).To(Succeed())
+
+			Expect(out.Overlay).To(Equal(&calico.Overlay{Enabled: false}))
+		})
+
+		It("should enable the overlay if any IP family is encapsulated", func() {
+			out := &calico.NetworkConfig{}
+			Expect(scheme.Convert(&NetworkConfig{
+				IPv4: &IPv4{Encapsulation: ptr.To(EncapsulationNone)},
+				IPv6: &IPv6{Encapsulation: ptr.To(EncapsulationVXLAN)},
+			}, out, nil)).To(Succeed())
+
+			Expect(out.Overlay).To(Equal(&calico.Overlay{Enabled: true}))
+		})
+
+		It("should convert the dataplane and the wireguard settings", func() {
+			out := &calico.NetworkConfig{}
+			Expect(scheme.Convert(&NetworkConfig{Dataplane: ptr.To(DataplaneEBPF), Wireguard: &Wireguard{Enabled: true}}, out, nil)).To(Succeed())
+
+			Expect(out.EbpfDataplane).To(Equal(&calico.EbpfDataplane{Enabled: true}))
+			Expect(out.WireguardEncryption).To(BeTrue())
+		})
+
+		It("should reject the nftables dataplane", func() {
+			Expect(scheme.Convert(&NetworkConfig{Dataplane: ptr.To(DataplaneNFTables)}, &calico.NetworkConfig{}, nil)).To(MatchError(ContainSubstring("unsupported value for dataplane")))
+		})
+
+		It("should reject unknown encapsulations", func() {
+			Expect(scheme.Convert(&NetworkConfig{IPv4: &IPv4{Encapsulation: ptr.To(Encapsulation("Geneve"))}}, &calico.NetworkConfig{}, nil)).To(MatchError(ContainSubstring("unsupported value for encapsulation")))
+		})
+	})
+
+	Describe("v1alpha1 to v1alpha2", func() {
+		convert := func(in *v1alpha1.NetworkConfig) *NetworkConfig {
+			internal := &calico.NetworkConfig{}
+			Expect(scheme.Convert(in, internal, nil)).To(Succeed())
+			out := &NetworkConfig{}
+			Expect(scheme.Convert(internal, out, nil)).To(Succeed())
+			return out
+		}
+
+		It("should fold the overlay settings into the encapsulation", func() {
+			out := convert(&v1alpha1.NetworkConfig{
+				Overlay: &v1alpha1.Overlay{Enabled: true},
+				VXLAN:   &v1alpha1.VXLAN{Enabled: true},
+				IPv4:    &v1alpha1.IPv4{Mode: ptr.To(v1alpha1.Always)},
+			})
+
+			Expect(out.IPv4.Encapsulation).To(Equal(ptr.To(EncapsulationVXLAN)))
+			Expect(out.Backend).To(BeNil())
+		})
+
+		It("should not fold the overlay settings into the IPv6 encapsulation", func() {
+			out := convert(&v1alpha1.NetworkConfig{
+				Overlay: &v1alpha1.Overlay{Enabled: true},
+				IPv6:    &v1alpha1.IPv6{},
+			})
+
+			Expect(out.IPv6.Encapsulation).To(Equal(ptr.To(EncapsulationNone)))
+		})
+
+		It("should set the backend to none if the overlay is disabled without pod routes", func() {
+			out := convert(&v1alpha1.NetworkConfig{
+				Overlay: &v1alpha1.Overlay{Enabled: false},
+				IPv4:    &v1alpha1.IPv4{Pool: ptr.To(v1alpha1.PoolIPIP)},
+			})
+
+			Expect(out.IPv4.Encapsulation).To(Equal(ptr.To(EncapsulationNone)))
+			Expect(out.Backend).To(Equal(ptr.To(None)))
+		})
+
+		It("should move the deprecated fields to the IPv4 settings", func() {
+			out := convert(&v1alpha1.NetworkConfig{
+				IPIP:                  ptr.To(v1alpha1.CrossSubnet),
+				IPAutoDetectionMethod: ptr.To("interface=eth0"),
+			})
+
+			Expect(out.IPv4).To(Equal(&IPv4{Encapsulation: ptr.To(EncapsulationIPIPCrossSubnet), AutoDetectionMethod: ptr.To("interface=eth0")}))
+		})
+	})
+})
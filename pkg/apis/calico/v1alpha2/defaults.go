@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_NetworkConfig sets the defaults of the network config. The encapsulation is not defaulted, because the
+// default IP pools are only managed by the extension if it is set.
+func SetDefaults_NetworkConfig(obj *NetworkConfig) {
+	if obj.Backend == nil {
+		obj.Backend = ptr.To(Bird)
+	}
+
+	if obj.IPAM == nil {
+		obj.IPAM = &IPAM{}
+	}
+	if obj.IPAM.Type == "" {
+		// VXLAN implies calico-ipam.
+		obj.IPAM.Type = IPAMHostLocal
+		if encapsulation := ipv4Encapsulation(obj); encapsulation != nil && (*encapsulation == EncapsulationVXLAN || *encapsulation == EncapsulationVXLANCrossSubnet) {
+			obj.IPAM.Type = IPAMCalico
+		}
+	}
+
+	if obj.Typha == nil {
+		obj.Typha = &Typha{Enabled: true}
+	}
+
+	// Source NAT to the upstream DNS servers is only relevant if the pod traffic is not encapsulated.
+	if obj.SnatToUpstreamDNS == nil && unencapsulated(obj) {
+		obj.SnatToUpstreamDNS = &SnatToUpstreamDNS{Enabled: true}
+	}
+}
+
+// SetDefaults_AutoScaling sets the defaults of the autoscaling settings.
+func SetDefaults_AutoScaling(obj *AutoScaling) {
+	if obj.Mode == "" {
+		obj.Mode = AutoscalingModeClusterProportional
+	}
+}
+
+// unencapsulated returns true if the encapsulation is set for any IP family of the given network config and none of
+// them encapsulates the pod traffic, which corresponds to a disabled overlay.
+func unencapsulated(networkConfig *NetworkConfig) bool {
+	var set bool
+	for _, encapsulation := range []*Encapsulation{ipv4Encapsulation(networkConfig), ipv6Encapsulation(networkConfig)} {
+		if encapsulation == nil {
+			continue
+		}
+		if *encapsulation != EncapsulationNone {
+			return false
+		}
+		set = true
+	}
+	return set
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha2_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha2"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_NetworkConfig", func() {
+		It("should default an empty network config", func() {
+			obj := &NetworkConfig{}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj).To(Equal(&NetworkConfig{
+				Backend: ptr.To(Bird),
+				IPAM:    &IPAM{Type: IPAMHostLocal},
+				Typha:   &Typha{Enabled: true},
+			}))
+		})
+
+		It("should not overwrite explicit settings", func() {
+			obj := &NetworkConfig{
+				Backend:           ptr.To(None),
+				IPAM:              &IPAM{Type: IPAMCalico},
+				Typha:             &Typha{Enabled: false},
+				SnatToUpstreamDNS: &SnatToUpstreamDNS{Enabled: false},
+				AutoScaling:       &AutoScaling{Mode: AutoscalingModeVPA},
+				IPv4:              &IPv4{Encapsulation: ptr.To(EncapsulationNone)},
+			}
+			expected := obj.DeepCopy()
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj).To(Equal(expected))
+		})
+
+		It("should default the IPAM type to calico-ipam with VXLAN encapsulation", func() {
+			obj := &NetworkConfig{IPv4: &IPv4{Encapsulation: ptr.To(EncapsulationVXLANCrossSubnet)}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.IPAM).To(Equal(&IPAM{Type: IPAMCalico}))
+			Expect(obj.SnatToUpstreamDNS).To(BeNil())
+		})
+
+		It("should enable source NAT to the upstream DNS servers if no IP family is encapsulated", func() {
+			obj := &NetworkConfig{
+				IPv4: &IPv4{Encapsulation: ptr.To(EncapsulationNone)},
+				IPv6: &IPv6{Encapsulation: ptr.To(EncapsulationNone)},
+			}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.SnatToUpstreamDNS).To(Equal(&SnatToUpstreamDNS{Enabled: true}))
+		})
+
+		It("should default the autoscaling mode", func() {
+			obj := &NetworkConfig{AutoScaling: &AutoScaling{}}
+			SetObjectDefaults_NetworkConfig(obj)
+
+			Expect(obj.AutoScaling.Mode).To(Equal(AutoscalingModeClusterProportional))
+		})
+	})
+})
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+
+//go:generate crd-ref-docs --source-path=. --config=../../../../hack/api-reference/calico.yaml --renderer=markdown --templates-dir=$GARDENER_HACK_DIR/api-reference/template --log-level=ERROR --output-path=../../../../hack/api-reference/calico-v1alpha2.md
+
+// Package v1alpha2 contains the configuration of the Calico Network Extension.
+// +groupName=calico.networking.extensions.gardener.cloud
+package v1alpha2 // import "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha2"
@@ -0,0 +1,1048 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	unsafe "unsafe"
+
+	calico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AutoScaling)(nil), (*calico.AutoScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AutoScaling_To_calico_AutoScaling(a.(*AutoScaling), b.(*calico.AutoScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.AutoScaling)(nil), (*AutoScaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_AutoScaling_To_v1alpha2_AutoScaling(a.(*calico.AutoScaling), b.(*AutoScaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGP)(nil), (*calico.BGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BGP_To_calico_BGP(a.(*BGP), b.(*calico.BGP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGP)(nil), (*BGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGP_To_v1alpha2_BGP(a.(*calico.BGP), b.(*BGP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPPeer)(nil), (*calico.BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BGPPeer_To_calico_BGPPeer(a.(*BGPPeer), b.(*calico.BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPPeer)(nil), (*BGPPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPPeer_To_v1alpha2_BGPPeer(a.(*calico.BGPPeer), b.(*BGPPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPRouteReflector)(nil), (*calico.BGPRouteReflector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BGPRouteReflector_To_calico_BGPRouteReflector(a.(*BGPRouteReflector), b.(*calico.BGPRouteReflector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPRouteReflector)(nil), (*BGPRouteReflector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPRouteReflector_To_v1alpha2_BGPRouteReflector(a.(*calico.BGPRouteReflector), b.(*BGPRouteReflector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BGPServiceClusterIPs)(nil), (*calico.BGPServiceClusterIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(a.(*BGPServiceClusterIPs), b.(*calico.BGPServiceClusterIPs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BGPServiceClusterIPs)(nil), (*BGPServiceClusterIPs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BGPServiceClusterIPs_To_v1alpha2_BGPServiceClusterIPs(a.(*calico.BGPServiceClusterIPs), b.(*BGPServiceClusterIPs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BirdExporter)(nil), (*calico.BirdExporter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BirdExporter_To_calico_BirdExporter(a.(*BirdExporter), b.(*calico.BirdExporter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.BirdExporter)(nil), (*BirdExporter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_BirdExporter_To_v1alpha2_BirdExporter(a.(*calico.BirdExporter), b.(*BirdExporter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FelixConfiguration)(nil), (*calico.FelixConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FelixConfiguration_To_calico_FelixConfiguration(a.(*FelixConfiguration), b.(*calico.FelixConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixConfiguration)(nil), (*FelixConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixConfiguration_To_v1alpha2_FelixConfiguration(a.(*calico.FelixConfiguration), b.(*FelixConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FelixConntrackTimeouts)(nil), (*calico.FelixConntrackTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(a.(*FelixConntrackTimeouts), b.(*calico.FelixConntrackTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixConntrackTimeouts)(nil), (*FelixConntrackTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixConntrackTimeouts_To_v1alpha2_FelixConntrackTimeouts(a.(*calico.FelixConntrackTimeouts), b.(*FelixConntrackTimeouts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FelixProtoPort)(nil), (*calico.FelixProtoPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FelixProtoPort_To_calico_FelixProtoPort(a.(*FelixProtoPort), b.(*calico.FelixProtoPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.FelixProtoPort)(nil), (*FelixProtoPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_FelixProtoPort_To_v1alpha2_FelixProtoPort(a.(*calico.FelixProtoPort), b.(*FelixProtoPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAM)(nil), (*calico.IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAM_To_calico_IPAM(a.(*IPAM), b.(*calico.IPAM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPAM)(nil), (*IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPAM_To_v1alpha2_IPAM(a.(*calico.IPAM), b.(*IPAM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAMConfig)(nil), (*calico.IPAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAMConfig_To_calico_IPAMConfig(a.(*IPAMConfig), b.(*calico.IPAMConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.IPAMConfig)(nil), (*IPAMConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPAMConfig_To_v1alpha2_IPAMConfig(a.(*calico.IPAMConfig), b.(*IPAMConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MigrationStatus)(nil), (*calico.MigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MigrationStatus_To_calico_MigrationStatus(a.(*MigrationStatus), b.(*calico.MigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.MigrationStatus)(nil), (*MigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_MigrationStatus_To_v1alpha2_MigrationStatus(a.(*calico.MigrationStatus), b.(*MigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Multus)(nil), (*calico.Multus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Multus_To_calico_Multus(a.(*Multus), b.(*calico.Multus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.Multus)(nil), (*Multus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_Multus_To_v1alpha2_Multus(a.(*calico.Multus), b.(*Multus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeMigrationStatus)(nil), (*calico.NodeMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NodeMigrationStatus_To_calico_NodeMigrationStatus(a.(*NodeMigrationStatus), b.(*calico.NodeMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.NodeMigrationStatus)(nil), (*NodeMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_NodeMigrationStatus_To_v1alpha2_NodeMigrationStatus(a.(*calico.NodeMigrationStatus), b.(*NodeMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProfileStatus)(nil), (*calico.ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ProfileStatus_To_calico_ProfileStatus(a.(*ProfileStatus), b.(*calico.ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.ProfileStatus)(nil), (*ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_ProfileStatus_To_v1alpha2_ProfileStatus(a.(*calico.ProfileStatus), b.(*ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SnatToUpstreamDNS)(nil), (*calico.SnatToUpstreamDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(a.(*SnatToUpstreamDNS), b.(*calico.SnatToUpstreamDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.SnatToUpstreamDNS)(nil), (*SnatToUpstreamDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_SnatToUpstreamDNS_To_v1alpha2_SnatToUpstreamDNS(a.(*calico.SnatToUpstreamDNS), b.(*SnatToUpstreamDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Typha)(nil), (*calico.Typha)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Typha_To_calico_Typha(a.(*Typha), b.(*calico.Typha), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.Typha)(nil), (*Typha)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_Typha_To_v1alpha2_Typha(a.(*calico.Typha), b.(*Typha), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.Wireguard)(nil), (*Wireguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_Wireguard_To_v1alpha2_Wireguard(a.(*calico.Wireguard), b.(*Wireguard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WireguardStatus)(nil), (*calico.WireguardStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WireguardStatus_To_calico_WireguardStatus(a.(*WireguardStatus), b.(*calico.WireguardStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*calico.WireguardStatus)(nil), (*WireguardStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_WireguardStatus_To_v1alpha2_WireguardStatus(a.(*calico.WireguardStatus), b.(*WireguardStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.IPFamilyStatus)(nil), (*IPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(a.(*calico.IPFamilyStatus), b.(*IPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.IPPool)(nil), (*IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPPool_To_v1alpha2_IPPool(a.(*calico.IPPool), b.(*IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.IPv4)(nil), (*IPv4)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPv4_To_v1alpha2_IPv4(a.(*calico.IPv4), b.(*IPv4), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.IPv6)(nil), (*IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_IPv6_To_v1alpha2_IPv6(a.(*calico.IPv6), b.(*IPv6), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.NetworkConfig)(nil), (*NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_NetworkConfig_To_v1alpha2_NetworkConfig(a.(*calico.NetworkConfig), b.(*NetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.NetworkStatus)(nil), (*NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_NetworkStatus_To_v1alpha2_NetworkStatus(a.(*calico.NetworkStatus), b.(*NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*calico.WorkerPool)(nil), (*WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_calico_WorkerPool_To_v1alpha2_WorkerPool(a.(*calico.WorkerPool), b.(*WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPFamilyStatus)(nil), (*calico.IPFamilyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(a.(*IPFamilyStatus), b.(*calico.IPFamilyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPPool)(nil), (*calico.IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPool_To_calico_IPPool(a.(*IPPool), b.(*calico.IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPv4)(nil), (*calico.IPv4)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPv4_To_calico_IPv4(a.(*IPv4), b.(*calico.IPv4), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPv6)(nil), (*calico.IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPv6_To_calico_IPv6(a.(*IPv6), b.(*calico.IPv6), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkConfig)(nil), (*calico.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkConfig_To_calico_NetworkConfig(a.(*NetworkConfig), b.(*calico.NetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkStatus)(nil), (*calico.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkStatus_To_calico_NetworkStatus(a.(*NetworkStatus), b.(*calico.NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Wireguard)(nil), (*calico.Wireguard)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Wireguard_To_calico_Wireguard(a.(*Wireguard), b.(*calico.Wireguard), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*WorkerPool)(nil), (*calico.WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WorkerPool_To_calico_WorkerPool(a.(*WorkerPool), b.(*calico.WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_AutoScaling_To_calico_AutoScaling(in *AutoScaling, out *calico.AutoScaling, s conversion.Scope) error {
+	out.Mode = calico.AutoscalingMode(in.Mode)
+	out.Resources = (*calico.StaticResources)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha2_AutoScaling_To_calico_AutoScaling is an autogenerated conversion function.
+func Convert_v1alpha2_AutoScaling_To_calico_AutoScaling(in *AutoScaling, out *calico.AutoScaling, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AutoScaling_To_calico_AutoScaling(in, out, s)
+}
+
+func autoConvert_calico_AutoScaling_To_v1alpha2_AutoScaling(in *calico.AutoScaling, out *AutoScaling, s conversion.Scope) error {
+	out.Mode = AutoscalingMode(in.Mode)
+	out.Resources = (*Resources)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_calico_AutoScaling_To_v1alpha2_AutoScaling is an autogenerated conversion function.
+func Convert_calico_AutoScaling_To_v1alpha2_AutoScaling(in *calico.AutoScaling, out *AutoScaling, s conversion.Scope) error {
+	return autoConvert_calico_AutoScaling_To_v1alpha2_AutoScaling(in, out, s)
+}
+
+func autoConvert_v1alpha2_BGP_To_calico_BGP(in *BGP, out *calico.BGP, s conversion.Scope) error {
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeToNodeMeshEnabled = (*bool)(unsafe.Pointer(in.NodeToNodeMeshEnabled))
+	out.Peers = *(*[]calico.BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflector = (*calico.BGPRouteReflector)(unsafe.Pointer(in.RouteReflector))
+	out.ServiceClusterIPs = (*calico.BGPServiceClusterIPs)(unsafe.Pointer(in.ServiceClusterIPs))
+	out.ServiceExternalIPs = *(*[]calico.CIDR)(unsafe.Pointer(&in.ServiceExternalIPs))
+	out.ServiceLoadBalancerIPs = *(*[]calico.CIDR)(unsafe.Pointer(&in.ServiceLoadBalancerIPs))
+	return nil
+}
+
+// Convert_v1alpha2_BGP_To_calico_BGP is an autogenerated conversion function.
+func Convert_v1alpha2_BGP_To_calico_BGP(in *BGP, out *calico.BGP, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BGP_To_calico_BGP(in, out, s)
+}
+
+func autoConvert_calico_BGP_To_v1alpha2_BGP(in *calico.BGP, out *BGP, s conversion.Scope) error {
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeToNodeMeshEnabled = (*bool)(unsafe.Pointer(in.NodeToNodeMeshEnabled))
+	out.Peers = *(*[]BGPPeer)(unsafe.Pointer(&in.Peers))
+	out.RouteReflector = (*BGPRouteReflector)(unsafe.Pointer(in.RouteReflector))
+	out.ServiceClusterIPs = (*BGPServiceClusterIPs)(unsafe.Pointer(in.ServiceClusterIPs))
+	out.ServiceExternalIPs = *(*[]CIDR)(unsafe.Pointer(&in.ServiceExternalIPs))
+	out.ServiceLoadBalancerIPs = *(*[]CIDR)(unsafe.Pointer(&in.ServiceLoadBalancerIPs))
+	return nil
+}
+
+// Convert_calico_BGP_To_v1alpha2_BGP is an autogenerated conversion function.
+func Convert_calico_BGP_To_v1alpha2_BGP(in *calico.BGP, out *BGP, s conversion.Scope) error {
+	return autoConvert_calico_BGP_To_v1alpha2_BGP(in, out, s)
+}
+
+func autoConvert_v1alpha2_BGPPeer_To_calico_BGPPeer(in *BGPPeer, out *calico.BGPPeer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PeerIP = (*string)(unsafe.Pointer(in.PeerIP))
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.PeerSelector = (*string)(unsafe.Pointer(in.PeerSelector))
+	return nil
+}
+
+// Convert_v1alpha2_BGPPeer_To_calico_BGPPeer is an autogenerated conversion function.
+func Convert_v1alpha2_BGPPeer_To_calico_BGPPeer(in *BGPPeer, out *calico.BGPPeer, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BGPPeer_To_calico_BGPPeer(in, out, s)
+}
+
+func autoConvert_calico_BGPPeer_To_v1alpha2_BGPPeer(in *calico.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PeerIP = (*string)(unsafe.Pointer(in.PeerIP))
+	out.ASNumber = (*uint32)(unsafe.Pointer(in.ASNumber))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.PeerSelector = (*string)(unsafe.Pointer(in.PeerSelector))
+	return nil
+}
+
+// Convert_calico_BGPPeer_To_v1alpha2_BGPPeer is an autogenerated conversion function.
+func Convert_calico_BGPPeer_To_v1alpha2_BGPPeer(in *calico.BGPPeer, out *BGPPeer, s conversion.Scope) error {
+	return autoConvert_calico_BGPPeer_To_v1alpha2_BGPPeer(in, out, s)
+}
+
+func autoConvert_v1alpha2_BGPRouteReflector_To_calico_BGPRouteReflector(in *BGPRouteReflector, out *calico.BGPRouteReflector, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_v1alpha2_BGPRouteReflector_To_calico_BGPRouteReflector is an autogenerated conversion function.
+func Convert_v1alpha2_BGPRouteReflector_To_calico_BGPRouteReflector(in *BGPRouteReflector, out *calico.BGPRouteReflector, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BGPRouteReflector_To_calico_BGPRouteReflector(in, out, s)
+}
+
+func autoConvert_calico_BGPRouteReflector_To_v1alpha2_BGPRouteReflector(in *calico.BGPRouteReflector, out *BGPRouteReflector, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_calico_BGPRouteReflector_To_v1alpha2_BGPRouteReflector is an autogenerated conversion function.
+func Convert_calico_BGPRouteReflector_To_v1alpha2_BGPRouteReflector(in *calico.BGPRouteReflector, out *BGPRouteReflector, s conversion.Scope) error {
+	return autoConvert_calico_BGPRouteReflector_To_v1alpha2_BGPRouteReflector(in, out, s)
+}
+
+func autoConvert_v1alpha2_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in *BGPServiceClusterIPs, out *calico.BGPServiceClusterIPs, s conversion.Scope) error {
+	out.CIDRs = *(*[]calico.CIDR)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_v1alpha2_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs is an autogenerated conversion function.
+func Convert_v1alpha2_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in *BGPServiceClusterIPs, out *calico.BGPServiceClusterIPs, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BGPServiceClusterIPs_To_calico_BGPServiceClusterIPs(in, out, s)
+}
+
+func autoConvert_calico_BGPServiceClusterIPs_To_v1alpha2_BGPServiceClusterIPs(in *calico.BGPServiceClusterIPs, out *BGPServiceClusterIPs, s conversion.Scope) error {
+	out.CIDRs = *(*[]CIDR)(unsafe.Pointer(&in.CIDRs))
+	return nil
+}
+
+// Convert_calico_BGPServiceClusterIPs_To_v1alpha2_BGPServiceClusterIPs is an autogenerated conversion function.
+func Convert_calico_BGPServiceClusterIPs_To_v1alpha2_BGPServiceClusterIPs(in *calico.BGPServiceClusterIPs, out *BGPServiceClusterIPs, s conversion.Scope) error {
+	return autoConvert_calico_BGPServiceClusterIPs_To_v1alpha2_BGPServiceClusterIPs(in, out, s)
+}
+
+func autoConvert_v1alpha2_BirdExporter_To_calico_BirdExporter(in *BirdExporter, out *calico.BirdExporter, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha2_BirdExporter_To_calico_BirdExporter is an autogenerated conversion function.
+func Convert_v1alpha2_BirdExporter_To_calico_BirdExporter(in *BirdExporter, out *calico.BirdExporter, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BirdExporter_To_calico_BirdExporter(in, out, s)
+}
+
+func autoConvert_calico_BirdExporter_To_v1alpha2_BirdExporter(in *calico.BirdExporter, out *BirdExporter, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_calico_BirdExporter_To_v1alpha2_BirdExporter is an autogenerated conversion function.
+func Convert_calico_BirdExporter_To_v1alpha2_BirdExporter(in *calico.BirdExporter, out *BirdExporter, s conversion.Scope) error {
+	return autoConvert_calico_BirdExporter_To_v1alpha2_BirdExporter(in, out, s)
+}
+
+func autoConvert_v1alpha2_FelixConfiguration_To_calico_FelixConfiguration(in *FelixConfiguration, out *calico.FelixConfiguration, s conversion.Scope) error {
+	out.LogSeverityScreen = (*string)(unsafe.Pointer(in.LogSeverityScreen))
+	out.RouteRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.RouteRefreshInterval))
+	out.IptablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.IptablesRefreshInterval))
+	out.NftablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.NftablesRefreshInterval))
+	out.BPFConntrackTimeouts = (*calico.FelixConntrackTimeouts)(unsafe.Pointer(in.BPFConntrackTimeouts))
+	out.FailsafeInboundHostPorts = *(*[]calico.FelixProtoPort)(unsafe.Pointer(&in.FailsafeInboundHostPorts))
+	out.FailsafeOutboundHostPorts = *(*[]calico.FelixProtoPort)(unsafe.Pointer(&in.FailsafeOutboundHostPorts))
+	out.NATPortRange = (*string)(unsafe.Pointer(in.NATPortRange))
+	out.FlowLogsFlushInterval = (*v1.Duration)(unsafe.Pointer(in.FlowLogsFlushInterval))
+	out.FlowLogsPolicyEvaluationMode = (*string)(unsafe.Pointer(in.FlowLogsPolicyEvaluationMode))
+	return nil
+}
+
+// Convert_v1alpha2_FelixConfiguration_To_calico_FelixConfiguration is an autogenerated conversion function.
+func Convert_v1alpha2_FelixConfiguration_To_calico_FelixConfiguration(in *FelixConfiguration, out *calico.FelixConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FelixConfiguration_To_calico_FelixConfiguration(in, out, s)
+}
+
+func autoConvert_calico_FelixConfiguration_To_v1alpha2_FelixConfiguration(in *calico.FelixConfiguration, out *FelixConfiguration, s conversion.Scope) error {
+	out.LogSeverityScreen = (*string)(unsafe.Pointer(in.LogSeverityScreen))
+	out.RouteRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.RouteRefreshInterval))
+	out.IptablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.IptablesRefreshInterval))
+	out.NftablesRefreshInterval = (*v1.Duration)(unsafe.Pointer(in.NftablesRefreshInterval))
+	out.BPFConntrackTimeouts = (*FelixConntrackTimeouts)(unsafe.Pointer(in.BPFConntrackTimeouts))
+	out.FailsafeInboundHostPorts = *(*[]FelixProtoPort)(unsafe.Pointer(&in.FailsafeInboundHostPorts))
+	out.FailsafeOutboundHostPorts = *(*[]FelixProtoPort)(unsafe.Pointer(&in.FailsafeOutboundHostPorts))
+	out.NATPortRange = (*string)(unsafe.Pointer(in.NATPortRange))
+	out.FlowLogsFlushInterval = (*v1.Duration)(unsafe.Pointer(in.FlowLogsFlushInterval))
+	out.FlowLogsPolicyEvaluationMode = (*string)(unsafe.Pointer(in.FlowLogsPolicyEvaluationMode))
+	return nil
+}
+
+// Convert_calico_FelixConfiguration_To_v1alpha2_FelixConfiguration is an autogenerated conversion function.
+func Convert_calico_FelixConfiguration_To_v1alpha2_FelixConfiguration(in *calico.FelixConfiguration, out *FelixConfiguration, s conversion.Scope) error {
+	return autoConvert_calico_FelixConfiguration_To_v1alpha2_FelixConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha2_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in *FelixConntrackTimeouts, out *calico.FelixConntrackTimeouts, s conversion.Scope) error {
+	out.CreationGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CreationGracePeriod))
+	out.TCPSynSent = (*v1.Duration)(unsafe.Pointer(in.TCPSynSent))
+	out.TCPEstablished = (*v1.Duration)(unsafe.Pointer(in.TCPEstablished))
+	out.TCPFinsSeen = (*v1.Duration)(unsafe.Pointer(in.TCPFinsSeen))
+	out.TCPResetSeen = (*v1.Duration)(unsafe.Pointer(in.TCPResetSeen))
+	out.UDPTimeout = (*v1.Duration)(unsafe.Pointer(in.UDPTimeout))
+	out.GenericTimeout = (*v1.Duration)(unsafe.Pointer(in.GenericTimeout))
+	out.ICMPTimeout = (*v1.Duration)(unsafe.Pointer(in.ICMPTimeout))
+	return nil
+}
+
+// Convert_v1alpha2_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts is an autogenerated conversion function.
+func Convert_v1alpha2_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in *FelixConntrackTimeouts, out *calico.FelixConntrackTimeouts, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FelixConntrackTimeouts_To_calico_FelixConntrackTimeouts(in, out, s)
+}
+
+func autoConvert_calico_FelixConntrackTimeouts_To_v1alpha2_FelixConntrackTimeouts(in *calico.FelixConntrackTimeouts, out *FelixConntrackTimeouts, s conversion.Scope) error {
+	out.CreationGracePeriod = (*v1.Duration)(unsafe.Pointer(in.CreationGracePeriod))
+	out.TCPSynSent = (*v1.Duration)(unsafe.Pointer(in.TCPSynSent))
+	out.TCPEstablished = (*v1.Duration)(unsafe.Pointer(in.TCPEstablished))
+	out.TCPFinsSeen = (*v1.Duration)(unsafe.Pointer(in.TCPFinsSeen))
+	out.TCPResetSeen = (*v1.Duration)(unsafe.Pointer(in.TCPResetSeen))
+	out.UDPTimeout = (*v1.Duration)(unsafe.Pointer(in.UDPTimeout))
+	out.GenericTimeout = (*v1.Duration)(unsafe.Pointer(in.GenericTimeout))
+	out.ICMPTimeout = (*v1.Duration)(unsafe.Pointer(in.ICMPTimeout))
+	return nil
+}
+
+// Convert_calico_FelixConntrackTimeouts_To_v1alpha2_FelixConntrackTimeouts is an autogenerated conversion function.
+func Convert_calico_FelixConntrackTimeouts_To_v1alpha2_FelixConntrackTimeouts(in *calico.FelixConntrackTimeouts, out *FelixConntrackTimeouts, s conversion.Scope) error {
+	return autoConvert_calico_FelixConntrackTimeouts_To_v1alpha2_FelixConntrackTimeouts(in, out, s)
+}
+
+func autoConvert_v1alpha2_FelixProtoPort_To_calico_FelixProtoPort(in *FelixProtoPort, out *calico.FelixProtoPort, s conversion.Scope) error {
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.Net = (*string)(unsafe.Pointer(in.Net))
+	return nil
+}
+
+// Convert_v1alpha2_FelixProtoPort_To_calico_FelixProtoPort is an autogenerated conversion function.
+func Convert_v1alpha2_FelixProtoPort_To_calico_FelixProtoPort(in *FelixProtoPort, out *calico.FelixProtoPort, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FelixProtoPort_To_calico_FelixProtoPort(in, out, s)
+}
+
+func autoConvert_calico_FelixProtoPort_To_v1alpha2_FelixProtoPort(in *calico.FelixProtoPort, out *FelixProtoPort, s conversion.Scope) error {
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.Net = (*string)(unsafe.Pointer(in.Net))
+	return nil
+}
+
+// Convert_calico_FelixProtoPort_To_v1alpha2_FelixProtoPort is an autogenerated conversion function.
+func Convert_calico_FelixProtoPort_To_v1alpha2_FelixProtoPort(in *calico.FelixProtoPort, out *FelixProtoPort, s conversion.Scope) error {
+	return autoConvert_calico_FelixProtoPort_To_v1alpha2_FelixProtoPort(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPAM_To_calico_IPAM(in *IPAM, out *calico.IPAM, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CIDR = (*calico.CIDR)(unsafe.Pointer(in.CIDR))
+	out.Config = (*calico.IPAMConfig)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_v1alpha2_IPAM_To_calico_IPAM is an autogenerated conversion function.
+func Convert_v1alpha2_IPAM_To_calico_IPAM(in *IPAM, out *calico.IPAM, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPAM_To_calico_IPAM(in, out, s)
+}
+
+func autoConvert_calico_IPAM_To_v1alpha2_IPAM(in *calico.IPAM, out *IPAM, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CIDR = (*CIDR)(unsafe.Pointer(in.CIDR))
+	out.Config = (*IPAMConfig)(unsafe.Pointer(in.Config))
+	return nil
+}
+
+// Convert_calico_IPAM_To_v1alpha2_IPAM is an autogenerated conversion function.
+func Convert_calico_IPAM_To_v1alpha2_IPAM(in *calico.IPAM, out *IPAM, s conversion.Scope) error {
+	return autoConvert_calico_IPAM_To_v1alpha2_IPAM(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPAMConfig_To_calico_IPAMConfig(in *IPAMConfig, out *calico.IPAMConfig, s conversion.Scope) error {
+	out.StrictAffinity = (*bool)(unsafe.Pointer(in.StrictAffinity))
+	out.MaxBlocksPerHost = (*int32)(unsafe.Pointer(in.MaxBlocksPerHost))
+	out.AutoAllocateBlocks = (*bool)(unsafe.Pointer(in.AutoAllocateBlocks))
+	return nil
+}
+
+// Convert_v1alpha2_IPAMConfig_To_calico_IPAMConfig is an autogenerated conversion function.
+func Convert_v1alpha2_IPAMConfig_To_calico_IPAMConfig(in *IPAMConfig, out *calico.IPAMConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPAMConfig_To_calico_IPAMConfig(in, out, s)
+}
+
+func autoConvert_calico_IPAMConfig_To_v1alpha2_IPAMConfig(in *calico.IPAMConfig, out *IPAMConfig, s conversion.Scope) error {
+	out.StrictAffinity = (*bool)(unsafe.Pointer(in.StrictAffinity))
+	out.MaxBlocksPerHost = (*int32)(unsafe.Pointer(in.MaxBlocksPerHost))
+	out.AutoAllocateBlocks = (*bool)(unsafe.Pointer(in.AutoAllocateBlocks))
+	return nil
+}
+
+// Convert_calico_IPAMConfig_To_v1alpha2_IPAMConfig is an autogenerated conversion function.
+func Convert_calico_IPAMConfig_To_v1alpha2_IPAMConfig(in *calico.IPAMConfig, out *IPAMConfig, s conversion.Scope) error {
+	return autoConvert_calico_IPAMConfig_To_v1alpha2_IPAMConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(in *IPFamilyStatus, out *calico.IPFamilyStatus, s conversion.Scope) error {
+	// WARNING: in.Encapsulation requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = in.BlockSize
+	return nil
+}
+
+func autoConvert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(in *calico.IPFamilyStatus, out *IPFamilyStatus, s conversion.Scope) error {
+	// WARNING: in.Pool requires manual conversion: does not exist in peer-type
+	// WARNING: in.Mode requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = in.BlockSize
+	return nil
+}
+
+func autoConvert_v1alpha2_IPPool_To_calico_IPPool(in *IPPool, out *calico.IPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = calico.CIDR(in.CIDR)
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	out.Encapsulation = (*calico.Pool)(unsafe.Pointer(in.Encapsulation))
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.NamespaceSelector = (*string)(unsafe.Pointer(in.NamespaceSelector))
+	out.AllowedUses = *(*[]calico.IPPoolAllowedUse)(unsafe.Pointer(&in.AllowedUses))
+	return nil
+}
+
+func autoConvert_calico_IPPool_To_v1alpha2_IPPool(in *calico.IPPool, out *IPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = CIDR(in.CIDR)
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	out.Encapsulation = (*Encapsulation)(unsafe.Pointer(in.Encapsulation))
+	// WARNING: in.Mode requires manual conversion: does not exist in peer-type
+	out.NATOutgoing = (*bool)(unsafe.Pointer(in.NATOutgoing))
+	out.NodeSelector = (*string)(unsafe.Pointer(in.NodeSelector))
+	out.NamespaceSelector = (*string)(unsafe.Pointer(in.NamespaceSelector))
+	out.AllowedUses = *(*[]IPPoolAllowedUse)(unsafe.Pointer(&in.AllowedUses))
+	return nil
+}
+
+func autoConvert_v1alpha2_IPv4_To_calico_IPv4(in *IPv4, out *calico.IPv4, s conversion.Scope) error {
+	// WARNING: in.Encapsulation requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	return nil
+}
+
+func autoConvert_calico_IPv4_To_v1alpha2_IPv4(in *calico.IPv4, out *IPv4, s conversion.Scope) error {
+	// WARNING: in.Pool requires manual conversion: does not exist in peer-type
+	// WARNING: in.Mode requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	return nil
+}
+
+func autoConvert_v1alpha2_IPv6_To_calico_IPv6(in *IPv6, out *calico.IPv6, s conversion.Scope) error {
+	// WARNING: in.Encapsulation requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.SourceNATEnabled = (*bool)(unsafe.Pointer(in.SourceNATEnabled))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	return nil
+}
+
+func autoConvert_calico_IPv6_To_v1alpha2_IPv6(in *calico.IPv6, out *IPv6, s conversion.Scope) error {
+	// WARNING: in.Pool requires manual conversion: does not exist in peer-type
+	// WARNING: in.Mode requires manual conversion: does not exist in peer-type
+	out.AutoDetectionMethod = (*string)(unsafe.Pointer(in.AutoDetectionMethod))
+	out.SourceNATEnabled = (*bool)(unsafe.Pointer(in.SourceNATEnabled))
+	out.BlockSize = (*int32)(unsafe.Pointer(in.BlockSize))
+	return nil
+}
+
+func autoConvert_v1alpha2_MigrationStatus_To_calico_MigrationStatus(in *MigrationStatus, out *calico.MigrationStatus, s conversion.Scope) error {
+	out.Type = calico.MigrationType(in.Type)
+	out.Phase = calico.MigrationPhase(in.Phase)
+	out.Nodes = *(*[]calico.NodeMigrationStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_v1alpha2_MigrationStatus_To_calico_MigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha2_MigrationStatus_To_calico_MigrationStatus(in *MigrationStatus, out *calico.MigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MigrationStatus_To_calico_MigrationStatus(in, out, s)
+}
+
+func autoConvert_calico_MigrationStatus_To_v1alpha2_MigrationStatus(in *calico.MigrationStatus, out *MigrationStatus, s conversion.Scope) error {
+	out.Type = MigrationType(in.Type)
+	out.Phase = MigrationPhase(in.Phase)
+	out.Nodes = *(*[]NodeMigrationStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_calico_MigrationStatus_To_v1alpha2_MigrationStatus is an autogenerated conversion function.
+func Convert_calico_MigrationStatus_To_v1alpha2_MigrationStatus(in *calico.MigrationStatus, out *MigrationStatus, s conversion.Scope) error {
+	return autoConvert_calico_MigrationStatus_To_v1alpha2_MigrationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_Multus_To_calico_Multus(in *Multus, out *calico.Multus, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.InstallCNIPlugins = (*bool)(unsafe.Pointer(in.InstallCNIPlugins))
+	return nil
+}
+
+// Convert_v1alpha2_Multus_To_calico_Multus is an autogenerated conversion function.
+func Convert_v1alpha2_Multus_To_calico_Multus(in *Multus, out *calico.Multus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Multus_To_calico_Multus(in, out, s)
+}
+
+func autoConvert_calico_Multus_To_v1alpha2_Multus(in *calico.Multus, out *Multus, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.InstallCNIPlugins = (*bool)(unsafe.Pointer(in.InstallCNIPlugins))
+	return nil
+}
+
+// Convert_calico_Multus_To_v1alpha2_Multus is an autogenerated conversion function.
+func Convert_calico_Multus_To_v1alpha2_Multus(in *calico.Multus, out *Multus, s conversion.Scope) error {
+	return autoConvert_calico_Multus_To_v1alpha2_Multus(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkConfig_To_calico_NetworkConfig(in *NetworkConfig, out *calico.NetworkConfig, s conversion.Scope) error {
+	out.Profile = (*string)(unsafe.Pointer(in.Profile))
+	out.Backend = (*calico.Backend)(unsafe.Pointer(in.Backend))
+	// WARNING: in.Dataplane requires manual conversion: does not exist in peer-type
+	out.IPAM = (*calico.IPAM)(unsafe.Pointer(in.IPAM))
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(calico.IPv4)
+		if err := Convert_v1alpha2_IPv4_To_calico_IPv4(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv4 = nil
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(calico.IPv6)
+		if err := Convert_v1alpha2_IPv6_To_calico_IPv6(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv6 = nil
+	}
+	out.Typha = (*calico.Typha)(unsafe.Pointer(in.Typha))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	out.SnatToUpstreamDNS = (*calico.SnatToUpstreamDNS)(unsafe.Pointer(in.SnatToUpstreamDNS))
+	out.AutoScaling = (*calico.AutoScaling)(unsafe.Pointer(in.AutoScaling))
+	if in.Wireguard != nil {
+		in, out := &in.Wireguard, &out.Wireguard
+		*out = new(calico.Wireguard)
+		if err := Convert_v1alpha2_Wireguard_To_calico_Wireguard(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Wireguard = nil
+	}
+	out.BirdExporter = (*calico.BirdExporter)(unsafe.Pointer(in.BirdExporter))
+	out.Multus = (*calico.Multus)(unsafe.Pointer(in.Multus))
+	out.ServiceLoopPrevention = (*calico.ServiceLoopPrevention)(unsafe.Pointer(in.ServiceLoopPrevention))
+	out.BGP = (*calico.BGP)(unsafe.Pointer(in.BGP))
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]calico.IPPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_IPPool_To_calico_IPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IPPools = nil
+	}
+	out.Felix = (*calico.FelixConfiguration)(unsafe.Pointer(in.Felix))
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]calico.WorkerPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_WorkerPool_To_calico_WorkerPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkerPools = nil
+	}
+	return nil
+}
+
+func autoConvert_calico_NetworkConfig_To_v1alpha2_NetworkConfig(in *calico.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	out.Profile = (*string)(unsafe.Pointer(in.Profile))
+	out.Backend = (*Backend)(unsafe.Pointer(in.Backend))
+	out.IPAM = (*IPAM)(unsafe.Pointer(in.IPAM))
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(IPv4)
+		if err := Convert_calico_IPv4_To_v1alpha2_IPv4(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv4 = nil
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPv6)
+		if err := Convert_calico_IPv6_To_v1alpha2_IPv6(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv6 = nil
+	}
+	out.Typha = (*Typha)(unsafe.Pointer(in.Typha))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	// WARNING: in.EbpfDataplane requires manual conversion: does not exist in peer-type
+	// WARNING: in.Overlay requires manual conversion: does not exist in peer-type
+	out.SnatToUpstreamDNS = (*SnatToUpstreamDNS)(unsafe.Pointer(in.SnatToUpstreamDNS))
+	out.AutoScaling = (*AutoScaling)(unsafe.Pointer(in.AutoScaling))
+	// WARNING: in.VXLAN requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPAutoDetectionMethod requires manual conversion: does not exist in peer-type
+	// WARNING: in.WireguardEncryption requires manual conversion: does not exist in peer-type
+	if in.Wireguard != nil {
+		in, out := &in.Wireguard, &out.Wireguard
+		*out = new(Wireguard)
+		if err := Convert_calico_Wireguard_To_v1alpha2_Wireguard(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Wireguard = nil
+	}
+	out.BirdExporter = (*BirdExporter)(unsafe.Pointer(in.BirdExporter))
+	out.Multus = (*Multus)(unsafe.Pointer(in.Multus))
+	out.ServiceLoopPrevention = (*ServiceLoopPrevention)(unsafe.Pointer(in.ServiceLoopPrevention))
+	out.BGP = (*BGP)(unsafe.Pointer(in.BGP))
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			if err := Convert_calico_IPPool_To_v1alpha2_IPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.IPPools = nil
+	}
+	out.Felix = (*FelixConfiguration)(unsafe.Pointer(in.Felix))
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPool, len(*in))
+		for i := range *in {
+			if err := Convert_calico_WorkerPool_To_v1alpha2_WorkerPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkerPools = nil
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_NetworkStatus_To_calico_NetworkStatus(in *NetworkStatus, out *calico.NetworkStatus, s conversion.Scope) error {
+	out.Backend = (*calico.Backend)(unsafe.Pointer(in.Backend))
+	out.IPAM = (*string)(unsafe.Pointer(in.IPAM))
+	out.Dataplane = (*calico.Dataplane)(unsafe.Pointer(in.Dataplane))
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(calico.IPFamilyStatus)
+		if err := Convert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv4 = nil
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(calico.IPFamilyStatus)
+		if err := Convert_v1alpha2_IPFamilyStatus_To_calico_IPFamilyStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv6 = nil
+	}
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	out.Wireguard = (*calico.WireguardStatus)(unsafe.Pointer(in.Wireguard))
+	out.Profile = (*calico.ProfileStatus)(unsafe.Pointer(in.Profile))
+	out.Migration = (*calico.MigrationStatus)(unsafe.Pointer(in.Migration))
+	return nil
+}
+
+func autoConvert_calico_NetworkStatus_To_v1alpha2_NetworkStatus(in *calico.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	out.Backend = (*Backend)(unsafe.Pointer(in.Backend))
+	out.IPAM = (*string)(unsafe.Pointer(in.IPAM))
+	out.Dataplane = (*Dataplane)(unsafe.Pointer(in.Dataplane))
+	// WARNING: in.OverlayEnabled requires manual conversion: does not exist in peer-type
+	// WARNING: in.VXLANEnabled requires manual conversion: does not exist in peer-type
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(IPFamilyStatus)
+		if err := Convert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv4 = nil
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPFamilyStatus)
+		if err := Convert_calico_IPFamilyStatus_To_v1alpha2_IPFamilyStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IPv6 = nil
+	}
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	out.Wireguard = (*WireguardStatus)(unsafe.Pointer(in.Wireguard))
+	out.Profile = (*ProfileStatus)(unsafe.Pointer(in.Profile))
+	out.Migration = (*MigrationStatus)(unsafe.Pointer(in.Migration))
+	return nil
+}
+
+func autoConvert_v1alpha2_NodeMigrationStatus_To_calico_NodeMigrationStatus(in *NodeMigrationStatus, out *calico.NodeMigrationStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.PreviousWireguardPublicKeys = *(*[]string)(unsafe.Pointer(&in.PreviousWireguardPublicKeys))
+	return nil
+}
+
+// Convert_v1alpha2_NodeMigrationStatus_To_calico_NodeMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha2_NodeMigrationStatus_To_calico_NodeMigrationStatus(in *NodeMigrationStatus, out *calico.NodeMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NodeMigrationStatus_To_calico_NodeMigrationStatus(in, out, s)
+}
+
+func autoConvert_calico_NodeMigrationStatus_To_v1alpha2_NodeMigrationStatus(in *calico.NodeMigrationStatus, out *NodeMigrationStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Ready = in.Ready
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.PreviousWireguardPublicKeys = *(*[]string)(unsafe.Pointer(&in.PreviousWireguardPublicKeys))
+	return nil
+}
+
+// Convert_calico_NodeMigrationStatus_To_v1alpha2_NodeMigrationStatus is an autogenerated conversion function.
+func Convert_calico_NodeMigrationStatus_To_v1alpha2_NodeMigrationStatus(in *calico.NodeMigrationStatus, out *NodeMigrationStatus, s conversion.Scope) error {
+	return autoConvert_calico_NodeMigrationStatus_To_v1alpha2_NodeMigrationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_ProfileStatus_To_calico_ProfileStatus(in *ProfileStatus, out *calico.ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha2_ProfileStatus_To_calico_ProfileStatus is an autogenerated conversion function.
+func Convert_v1alpha2_ProfileStatus_To_calico_ProfileStatus(in *ProfileStatus, out *calico.ProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ProfileStatus_To_calico_ProfileStatus(in, out, s)
+}
+
+func autoConvert_calico_ProfileStatus_To_v1alpha2_ProfileStatus(in *calico.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_calico_ProfileStatus_To_v1alpha2_ProfileStatus is an autogenerated conversion function.
+func Convert_calico_ProfileStatus_To_v1alpha2_ProfileStatus(in *calico.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	return autoConvert_calico_ProfileStatus_To_v1alpha2_ProfileStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(in *SnatToUpstreamDNS, out *calico.SnatToUpstreamDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha2_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS is an autogenerated conversion function.
+func Convert_v1alpha2_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(in *SnatToUpstreamDNS, out *calico.SnatToUpstreamDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SnatToUpstreamDNS_To_calico_SnatToUpstreamDNS(in, out, s)
+}
+
+func autoConvert_calico_SnatToUpstreamDNS_To_v1alpha2_SnatToUpstreamDNS(in *calico.SnatToUpstreamDNS, out *SnatToUpstreamDNS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_calico_SnatToUpstreamDNS_To_v1alpha2_SnatToUpstreamDNS is an autogenerated conversion function.
+func Convert_calico_SnatToUpstreamDNS_To_v1alpha2_SnatToUpstreamDNS(in *calico.SnatToUpstreamDNS, out *SnatToUpstreamDNS, s conversion.Scope) error {
+	return autoConvert_calico_SnatToUpstreamDNS_To_v1alpha2_SnatToUpstreamDNS(in, out, s)
+}
+
+func autoConvert_v1alpha2_Typha_To_calico_Typha(in *Typha, out *calico.Typha, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha2_Typha_To_calico_Typha is an autogenerated conversion function.
+func Convert_v1alpha2_Typha_To_calico_Typha(in *Typha, out *calico.Typha, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Typha_To_calico_Typha(in, out, s)
+}
+
+func autoConvert_calico_Typha_To_v1alpha2_Typha(in *calico.Typha, out *Typha, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_calico_Typha_To_v1alpha2_Typha is an autogenerated conversion function.
+func Convert_calico_Typha_To_v1alpha2_Typha(in *calico.Typha, out *Typha, s conversion.Scope) error {
+	return autoConvert_calico_Typha_To_v1alpha2_Typha(in, out, s)
+}
+
+func autoConvert_v1alpha2_Wireguard_To_calico_Wireguard(in *Wireguard, out *calico.Wireguard, s conversion.Scope) error {
+	// WARNING: in.Enabled requires manual conversion: does not exist in peer-type
+	out.HostEncryptionEnabled = (*bool)(unsafe.Pointer(in.HostEncryptionEnabled))
+	out.RoutingRulePriority = (*int32)(unsafe.Pointer(in.RoutingRulePriority))
+	out.InterfaceName = (*string)(unsafe.Pointer(in.InterfaceName))
+	out.InterfaceNameV6 = (*string)(unsafe.Pointer(in.InterfaceNameV6))
+	out.ListeningPort = (*int32)(unsafe.Pointer(in.ListeningPort))
+	out.ListeningPortV6 = (*int32)(unsafe.Pointer(in.ListeningPortV6))
+	return nil
+}
+
+func autoConvert_calico_Wireguard_To_v1alpha2_Wireguard(in *calico.Wireguard, out *Wireguard, s conversion.Scope) error {
+	out.HostEncryptionEnabled = (*bool)(unsafe.Pointer(in.HostEncryptionEnabled))
+	out.RoutingRulePriority = (*int32)(unsafe.Pointer(in.RoutingRulePriority))
+	out.InterfaceName = (*string)(unsafe.Pointer(in.InterfaceName))
+	out.InterfaceNameV6 = (*string)(unsafe.Pointer(in.InterfaceNameV6))
+	out.ListeningPort = (*int32)(unsafe.Pointer(in.ListeningPort))
+	out.ListeningPortV6 = (*int32)(unsafe.Pointer(in.ListeningPortV6))
+	return nil
+}
+
+// Convert_calico_Wireguard_To_v1alpha2_Wireguard is an autogenerated conversion function.
+func Convert_calico_Wireguard_To_v1alpha2_Wireguard(in *calico.Wireguard, out *Wireguard, s conversion.Scope) error {
+	return autoConvert_calico_Wireguard_To_v1alpha2_Wireguard(in, out, s)
+}
+
+func autoConvert_v1alpha2_WireguardStatus_To_calico_WireguardStatus(in *WireguardStatus, out *calico.WireguardStatus, s conversion.Scope) error {
+	out.EncryptedNodes = in.EncryptedNodes
+	out.PendingNodes = in.PendingNodes
+	out.UnsupportedNodes = *(*[]string)(unsafe.Pointer(&in.UnsupportedNodes))
+	out.KeyRotation = (*string)(unsafe.Pointer(in.KeyRotation))
+	return nil
+}
+
+// Convert_v1alpha2_WireguardStatus_To_calico_WireguardStatus is an autogenerated conversion function.
+func Convert_v1alpha2_WireguardStatus_To_calico_WireguardStatus(in *WireguardStatus, out *calico.WireguardStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WireguardStatus_To_calico_WireguardStatus(in, out, s)
+}
+
+func autoConvert_calico_WireguardStatus_To_v1alpha2_WireguardStatus(in *calico.WireguardStatus, out *WireguardStatus, s conversion.Scope) error {
+	out.EncryptedNodes = in.EncryptedNodes
+	out.PendingNodes = in.PendingNodes
+	out.UnsupportedNodes = *(*[]string)(unsafe.Pointer(&in.UnsupportedNodes))
+	out.KeyRotation = (*string)(unsafe.Pointer(in.KeyRotation))
+	return nil
+}
+
+// Convert_calico_WireguardStatus_To_v1alpha2_WireguardStatus is an autogenerated conversion function.
+func Convert_calico_WireguardStatus_To_v1alpha2_WireguardStatus(in *calico.WireguardStatus, out *WireguardStatus, s conversion.Scope) error {
+	return autoConvert_calico_WireguardStatus_To_v1alpha2_WireguardStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_WorkerPool_To_calico_WorkerPool(in *WorkerPool, out *calico.WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	// WARNING: in.Dataplane requires manual conversion: does not exist in peer-type
+	out.IPv4AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv4AutoDetectionMethod))
+	out.IPv6AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv6AutoDetectionMethod))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	return nil
+}
+
+func autoConvert_calico_WorkerPool_To_v1alpha2_WorkerPool(in *calico.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	// WARNING: in.EbpfDataplane requires manual conversion: does not exist in peer-type
+	out.IPv4AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv4AutoDetectionMethod))
+	out.IPv6AutoDetectionMethod = (*string)(unsafe.Pointer(in.IPv6AutoDetectionMethod))
+	out.VethMTU = (*string)(unsafe.Pointer(in.VethMTU))
+	return nil
+}
@@ -0,0 +1,29 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by defaulter-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&NetworkConfig{}, func(obj interface{}) { SetObjectDefaults_NetworkConfig(obj.(*NetworkConfig)) })
+	return nil
+}
+
+func SetObjectDefaults_NetworkConfig(in *NetworkConfig) {
+	SetDefaults_NetworkConfig(in)
+	if in.AutoScaling != nil {
+		SetDefaults_AutoScaling(in.AutoScaling)
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+)
+
+// ValidateNetworkConfigConstraints validates the network config against the constraints the operator configured for
+// the shoots of the given provider type.
+func ValidateNetworkConfigConstraints(networkConfig *apiscalico.NetworkConfig, constraints *config.NetworkConfigConstraints, providerType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if constraints == nil {
+		return allErrs
+	}
+
+	if networkConfig.Backend != nil && len(constraints.AllowedBackends) > 0 && !slices.Contains(constraints.AllowedBackends, calicov1alpha1.Backend(*networkConfig.Backend)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("backend"), fmt.Sprintf("backend %q is not allowed by the operator for shoots on provider type %q, allowed backends are %q", *networkConfig.Backend, providerType, constraints.AllowedBackends)))
+	}
+
+	if networkConfig.IPAM != nil && len(constraints.AllowedIPAMTypes) > 0 && !slices.Contains(constraints.AllowedIPAMTypes, networkConfig.IPAM.Type) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipam", "type"), fmt.Sprintf("IPAM type %q is not allowed by the operator for shoots on provider type %q, allowed IPAM types are %q", networkConfig.IPAM.Type, providerType, constraints.AllowedIPAMTypes)))
+	}
+
+	if constraints.EbpfDataplaneForbidden && networkConfig.EbpfDataplane != nil && networkConfig.EbpfDataplane.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ebpfDataplane", "enabled"), fmt.Sprintf("the eBPF dataplane is forbidden by the operator for shoots on provider type %q", providerType)))
+	}
+
+	if constraints.WireguardEncryptionRequired && !networkConfig.WireguardEncryption {
+		allErrs = append(allErrs, field.Required(fldPath.Child("wireguardEncryption"), fmt.Sprintf("WireGuard encryption is required by the operator for shoots on provider type %q", providerType)))
+	}
+
+	return allErrs
+}
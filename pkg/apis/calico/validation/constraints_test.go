@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+)
+
+var _ = Describe("Constraints validation", func() {
+	DescribeTable("#ValidateNetworkConfigConstraints",
+		func(networkConfig *apiscalico.NetworkConfig, constraints *config.NetworkConfigConstraints, matcher gomegatypes.GomegaMatcher) {
+			Expect(validation.ValidateNetworkConfigConstraints(networkConfig, constraints, "aws", field.NewPath("config"))).To(matcher)
+		},
+
+		Entry("should succeed without constraints", &apiscalico.NetworkConfig{Backend: ptr.To(apiscalico.None)}, nil,
+			BeEmpty()),
+		Entry("should succeed with an allowed backend", &apiscalico.NetworkConfig{Backend: ptr.To(apiscalico.VXLan)},
+			&config.NetworkConfigConstraints{AllowedBackends: []calicov1alpha1.Backend{calicov1alpha1.Bird, calicov1alpha1.VXLan}},
+			BeEmpty()),
+		Entry("should succeed without backend", &apiscalico.NetworkConfig{},
+			&config.NetworkConfigConstraints{AllowedBackends: []calicov1alpha1.Backend{calicov1alpha1.VXLan}},
+			BeEmpty()),
+		Entry("should forbid a backend which is not allowed", &apiscalico.NetworkConfig{Backend: ptr.To(apiscalico.None)},
+			&config.NetworkConfigConstraints{AllowedBackends: []calicov1alpha1.Backend{calicov1alpha1.Bird, calicov1alpha1.VXLan}},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("config.backend"),
+				"Detail": Equal(`backend "none" is not allowed by the operator for shoots on provider type "aws", allowed backends are ["bird" "vxlan"]`),
+			})))),
+		Entry("should forbid an IPAM type which is not allowed", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "host-local"}},
+			&config.NetworkConfigConstraints{AllowedIPAMTypes: []string{"calico-ipam"}},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.type")})))),
+		Entry("should forbid the eBPF dataplane", &apiscalico.NetworkConfig{EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: true}},
+			&config.NetworkConfigConstraints{EbpfDataplaneForbidden: true},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ebpfDataplane.enabled")})))),
+		Entry("should succeed with the eBPF dataplane disabled", &apiscalico.NetworkConfig{EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: false}},
+			&config.NetworkConfigConstraints{EbpfDataplaneForbidden: true},
+			BeEmpty()),
+		Entry("should require WireGuard encryption", &apiscalico.NetworkConfig{},
+			&config.NetworkConfigConstraints{WireguardEncryptionRequired: true},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.wireguardEncryption")})))),
+		Entry("should succeed with WireGuard encryption", &apiscalico.NetworkConfig{WireguardEncryption: true},
+			&config.NetworkConfigConstraints{WireguardEncryptionRequired: true},
+			BeEmpty()),
+	)
+})
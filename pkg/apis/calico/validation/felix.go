@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+)
+
+// felixSetting is an allowlisted setting of felix. Its name is the name of the field in the FelixConfiguration resource
+// of calico.
+type felixSetting struct {
+	name     string
+	validate func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList
+}
+
+// felixSettings is the allowlist of the felix settings which can be configured in the network config. The validation of
+// the felix section is generated from it.
+var felixSettings = []felixSetting{
+	{"logSeverityScreen", enumSetting(func(f *apiscalico.FelixConfiguration) *string { return f.LogSeverityScreen }, "Debug", "Info", "Warning", "Error", "Fatal")},
+	{"routeRefreshInterval", durationSetting(func(f *apiscalico.FelixConfiguration) *metav1.Duration { return f.RouteRefreshInterval })},
+	{"iptablesRefreshInterval", durationSetting(func(f *apiscalico.FelixConfiguration) *metav1.Duration { return f.IptablesRefreshInterval })},
+	{"nftablesRefreshInterval", durationSetting(func(f *apiscalico.FelixConfiguration) *metav1.Duration { return f.NftablesRefreshInterval })},
+	{"bpfConntrackTimeouts", conntrackTimeoutsSetting(func(f *apiscalico.FelixConfiguration) *apiscalico.FelixConntrackTimeouts {
+		return f.BPFConntrackTimeouts
+	})},
+	{"failsafeInboundHostPorts", protoPortsSetting(func(f *apiscalico.FelixConfiguration) []apiscalico.FelixProtoPort { return f.FailsafeInboundHostPorts })},
+	{"failsafeOutboundHostPorts", protoPortsSetting(func(f *apiscalico.FelixConfiguration) []apiscalico.FelixProtoPort { return f.FailsafeOutboundHostPorts })},
+	{"natPortRange", portRangeSetting(func(f *apiscalico.FelixConfiguration) *string { return f.NATPortRange })},
+	{"flowLogsFlushInterval", durationSetting(func(f *apiscalico.FelixConfiguration) *metav1.Duration { return f.FlowLogsFlushInterval })},
+	{"flowLogsPolicyEvaluationMode", enumSetting(func(f *apiscalico.FelixConfiguration) *string { return f.FlowLogsPolicyEvaluationMode }, "None", "Continuous")},
+}
+
+// ValidateNetworkConfigFelix validates the felix settings in the network config against the allowlist.
+func ValidateNetworkConfigFelix(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if felix == nil {
+		return allErrs
+	}
+
+	for _, setting := range felixSettings {
+		allErrs = append(allErrs, setting.validate(felix, fldPath.Child(setting.name))...)
+	}
+
+	return allErrs
+}
+
+func enumSetting(get func(*apiscalico.FelixConfiguration) *string, values ...string) func(*apiscalico.FelixConfiguration, *field.Path) field.ErrorList {
+	return func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+		if value := get(felix); value != nil && !sets.New(values...).Has(*value) {
+			return field.ErrorList{field.NotSupported(fldPath, *value, values)}
+		}
+		return nil
+	}
+}
+
+func durationSetting(get func(*apiscalico.FelixConfiguration) *metav1.Duration) func(*apiscalico.FelixConfiguration, *field.Path) field.ErrorList {
+	return func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+		return validatePositiveDuration(get(felix), fldPath)
+	}
+}
+
+func conntrackTimeoutsSetting(get func(*apiscalico.FelixConfiguration) *apiscalico.FelixConntrackTimeouts) func(*apiscalico.FelixConfiguration, *field.Path) field.ErrorList {
+	return func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+		allErrs := field.ErrorList{}
+
+		timeouts := get(felix)
+		if timeouts == nil {
+			return allErrs
+		}
+
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.CreationGracePeriod, fldPath.Child("creationGracePeriod"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.TCPSynSent, fldPath.Child("tcpSynSent"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.TCPEstablished, fldPath.Child("tcpEstablished"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.TCPFinsSeen, fldPath.Child("tcpFinsSeen"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.TCPResetSeen, fldPath.Child("tcpResetSeen"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.UDPTimeout, fldPath.Child("udpTimeout"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.GenericTimeout, fldPath.Child("genericTimeout"))...)
+		allErrs = append(allErrs, validatePositiveDuration(timeouts.ICMPTimeout, fldPath.Child("icmpTimeout"))...)
+
+		return allErrs
+	}
+}
+
+func protoPortsSetting(get func(*apiscalico.FelixConfiguration) []apiscalico.FelixProtoPort) func(*apiscalico.FelixConfiguration, *field.Path) field.ErrorList {
+	return func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+		allErrs := field.ErrorList{}
+
+		protocols := []string{"tcp", "udp", "sctp"}
+		for i, protoPort := range get(felix) {
+			idxPath := fldPath.Index(i)
+
+			if protoPort.Protocol != nil && !sets.New(protocols...).Has(*protoPort.Protocol) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), *protoPort.Protocol, protocols))
+			}
+			for _, msg := range validation.IsValidPortNum(int(protoPort.Port)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("port"), protoPort.Port, msg))
+			}
+			if protoPort.Net != nil {
+				allErrs = append(allErrs, validation.IsValidCIDR(idxPath.Child("net"), *protoPort.Net)...)
+			}
+		}
+
+		return allErrs
+	}
+}
+
+func portRangeSetting(get func(*apiscalico.FelixConfiguration) *string) func(*apiscalico.FelixConfiguration, *field.Path) field.ErrorList {
+	return func(felix *apiscalico.FelixConfiguration, fldPath *field.Path) field.ErrorList {
+		portRange := get(felix)
+		if portRange == nil {
+			return nil
+		}
+
+		from, to, ok := strings.Cut(*portRange, ":")
+		fromPort, fromErr := strconv.Atoi(from)
+		toPort, toErr := strconv.Atoi(to)
+		if !ok || fromErr != nil || toErr != nil || len(validation.IsValidPortNum(fromPort)) > 0 || len(validation.IsValidPortNum(toPort)) > 0 || fromPort > toPort {
+			return field.ErrorList{field.Invalid(fldPath, *portRange, "must be a port range <from>:<to> with 1 <= from <= to <= 65535")}
+		}
+		return nil
+	}
+}
+
+func validatePositiveDuration(duration *metav1.Duration, fldPath *field.Path) field.ErrorList {
+	if duration != nil && duration.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath, duration.Duration.String(), "must be a positive duration")}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
+)
+
+var _ = Describe("Felix validation", func() {
+	DescribeTable("#ValidateNetworkConfigFelix",
+		func(felix *apiscalico.FelixConfiguration, matcher gomegatypes.GomegaMatcher) {
+			Expect(validation.ValidateNetworkConfigFelix(felix, field.NewPath("felix"))).To(matcher)
+		},
+
+		Entry("should succeed without felix settings", nil, BeEmpty()),
+		Entry("should succeed with valid felix settings", &apiscalico.FelixConfiguration{
+			LogSeverityScreen:       ptr.To("Warning"),
+			RouteRefreshInterval:    &metav1.Duration{Duration: time.Minute},
+			IptablesRefreshInterval: &metav1.Duration{Duration: 3 * time.Minute},
+			NftablesRefreshInterval: &metav1.Duration{Duration: 3 * time.Minute},
+			BPFConntrackTimeouts: &apiscalico.FelixConntrackTimeouts{
+				TCPEstablished: &metav1.Duration{Duration: time.Hour},
+				UDPTimeout:     &metav1.Duration{Duration: time.Minute},
+			},
+			FailsafeInboundHostPorts:     []apiscalico.FelixProtoPort{{Protocol: ptr.To("tcp"), Port: 22}, {Port: 179, Net: ptr.To("10.0.0.0/8")}},
+			FailsafeOutboundHostPorts:    []apiscalico.FelixProtoPort{{Protocol: ptr.To("udp"), Port: 53}},
+			NATPortRange:                 ptr.To("32768:65535"),
+			FlowLogsFlushInterval:        &metav1.Duration{Duration: 15 * time.Second},
+			FlowLogsPolicyEvaluationMode: ptr.To("Continuous"),
+		}, BeEmpty()),
+		Entry("should return error with unsupported log severity", &apiscalico.FelixConfiguration{LogSeverityScreen: ptr.To("Verbose")},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("felix.logSeverityScreen")})))),
+		Entry("should return error with non-positive refresh intervals", &apiscalico.FelixConfiguration{
+			RouteRefreshInterval:    &metav1.Duration{},
+			IptablesRefreshInterval: &metav1.Duration{Duration: -time.Second},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.routeRefreshInterval")})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.iptablesRefreshInterval")})),
+		)),
+		Entry("should return error with non-positive conntrack timeouts", &apiscalico.FelixConfiguration{
+			BPFConntrackTimeouts: &apiscalico.FelixConntrackTimeouts{ICMPTimeout: &metav1.Duration{}},
+		}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.bpfConntrackTimeouts.icmpTimeout")})))),
+		Entry("should return error with invalid failsafe ports", &apiscalico.FelixConfiguration{
+			FailsafeInboundHostPorts:  []apiscalico.FelixProtoPort{{Protocol: ptr.To("icmp"), Port: 22}},
+			FailsafeOutboundHostPorts: []apiscalico.FelixProtoPort{{Port: 0}, {Port: 53, Net: ptr.To("10.0.0.1")}},
+		}, ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.failsafeInboundHostPorts[0].protocol")})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.failsafeOutboundHostPorts[0].port")})),
+			PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.failsafeOutboundHostPorts[1].net")})),
+		)),
+		Entry("should return error with an invalid NAT port range", &apiscalico.FelixConfiguration{NATPortRange: ptr.To("65535:32768")},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.natPortRange")})))),
+		Entry("should return error with a NAT port range without upper bound", &apiscalico.FelixConfiguration{NATPortRange: ptr.To("32768")},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.natPortRange")})))),
+		Entry("should return error with unsupported flow logs policy evaluation mode", &apiscalico.FelixConfiguration{FlowLogsPolicyEvaluationMode: ptr.To("Periodic")},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("felix.flowLogsPolicyEvaluationMode")})))),
+	)
+})
@@ -6,7 +6,9 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -18,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
 )
 
 // ValidateNetworkConfig validates the network config.
@@ -43,6 +46,16 @@ func ValidateNetworkConfig(networkConfig *apiscalico.NetworkConfig, ipFamilies [
 
 	allErrs = append(allErrs, ValidateServiceLoopPrevention(networkConfig.ServiceLoopPrevention, fldPath.Child("serviceLoopPrevention"))...)
 
+	allErrs = append(allErrs, ValidateNetworkConfigBGP(networkConfig.BGP, networkConfig.Backend, ipFamilies, fldPath.Child("bgp"))...)
+
+	allErrs = append(allErrs, ValidateNetworkConfigIPPools(networkConfig, ipFamilies, fldPath.Child("ipPools"))...)
+
+	allErrs = append(allErrs, ValidateNetworkConfigFelix(networkConfig.Felix, fldPath.Child("felix"))...)
+
+	allErrs = append(allErrs, ValidateNetworkConfigWorkerPools(networkConfig.WorkerPools, ipFamilies, fldPath.Child("workerPools"))...)
+
+	allErrs = append(allErrs, ValidateNetworkConfigWireguard(networkConfig.Wireguard, networkConfig.WireguardEncryption, ipFamilies, fldPath.Child("wireguard"))...)
+
 	if networkConfig.IPIP != nil && !sets.New(apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet, apiscalico.Off).Has(*networkConfig.IPIP) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipip"), *networkConfig.IPIP, fmt.Sprintf("unsupported value %q for ipip, supported values are [%q, %q, %q, %q]", *networkConfig.IPIP, apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet, apiscalico.Off)))
 	}
@@ -54,7 +67,56 @@ func ValidateNetworkConfig(networkConfig *apiscalico.NetworkConfig, ipFamilies [
 	return allErrs
 }
 
-// ValidateNetworkConfigIPAM validates the kube-proxy configuration in the network config.
+// ValidateNetworkConfigUpdate validates an update of the network config. The IP families of the old shoot are
+// required as settings of an IP family must only be immutable once the shoot uses it.
+func ValidateNetworkConfigUpdate(oldNetworkConfig, networkConfig *apiscalico.NetworkConfig, oldIPFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldFamilies := sets.New(oldIPFamilies...)
+	if oldFamilies.Has(core.IPFamilyIPv4) {
+		var oldBlockSize, blockSize *int32
+		if oldNetworkConfig.IPv4 != nil {
+			oldBlockSize = oldNetworkConfig.IPv4.BlockSize
+		}
+		if networkConfig.IPv4 != nil {
+			blockSize = networkConfig.IPv4.BlockSize
+		}
+		allErrs = append(allErrs, validateBlockSizeUpdate(oldBlockSize, blockSize, 26, fldPath.Child("ipv4", "blockSize"))...)
+	}
+	if oldFamilies.Has(core.IPFamilyIPv6) {
+		var oldBlockSize, blockSize *int32
+		if oldNetworkConfig.IPv6 != nil {
+			oldBlockSize = oldNetworkConfig.IPv6.BlockSize
+		}
+		if networkConfig.IPv6 != nil {
+			blockSize = networkConfig.IPv6.BlockSize
+		}
+		allErrs = append(allErrs, validateBlockSizeUpdate(oldBlockSize, blockSize, 122, fldPath.Child("ipv6", "blockSize"))...)
+	}
+
+	return allErrs
+}
+
+// validateBlockSizeUpdate forbids changes of the block size of an existing IP pool, as calico cannot resize
+// the already allocated address blocks.
+func validateBlockSizeUpdate(oldBlockSize, blockSize *int32, defaultBlockSize int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	oldValue, newValue := defaultBlockSize, defaultBlockSize
+	if oldBlockSize != nil {
+		oldValue = *oldBlockSize
+	}
+	if blockSize != nil {
+		newValue = *blockSize
+	}
+	if oldValue != newValue {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("block size must not be changed from %d to %d, as the address blocks are already allocated", oldValue, newValue)))
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigIPAM validates the IPAM configuration in the network config.
 func ValidateNetworkConfigIPAM(ipam *apiscalico.IPAM, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -68,13 +130,27 @@ func ValidateNetworkConfigIPAM(ipam *apiscalico.IPAM, ipFamilies []core.IPFamily
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), ipam.Type, fmt.Sprintf("unsupported value %q for type, supported values are [%q, %q]", ipam.Type, apiscalico.IPAMCalico, apiscalico.IPAMHostLocal)))
 	}
 
-	// IPAMCalico is only allowed for IPv4 single-stack shoots (not for IPv6 or dual-stack)
-	if ipam.Type == apiscalico.IPAMCalico && sets.New(ipFamilies...).Has(core.IPFamilyIPv6) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), ipam.Type, fmt.Sprintf("IPAM type %q is only supported for IPv4 single-stack shoots, use %q instead", apiscalico.IPAMCalico, apiscalico.IPAMHostLocal)))
+	if ipam.CIDR != nil && strings.ToLower(string(*ipam.CIDR)) != "usepodcidr" {
+		cidrErrs := validation.IsValidCIDR(fldPath.Child("cidr"), string(*ipam.CIDR))
+		if ip, _, err := net.ParseCIDR(string(*ipam.CIDR)); len(cidrErrs) == 0 && err == nil && len(ipFamilies) > 0 {
+			ipFamily := core.IPFamilyIPv6
+			if ip.To4() != nil {
+				ipFamily = core.IPFamilyIPv4
+			}
+			if !sets.New(ipFamilies...).Has(ipFamily) {
+				cidrErrs = append(cidrErrs, field.Invalid(fldPath.Child("cidr"), *ipam.CIDR, fmt.Sprintf("IP family %s of the CIDR is not one of the shoot's IP families %q", ipFamily, ipFamilies)))
+			}
+		}
+		allErrs = append(allErrs, cidrErrs...)
 	}
 
-	if ipam.CIDR != nil && strings.ToLower(string(*ipam.CIDR)) != "usepodcidr" {
-		allErrs = append(allErrs, validation.IsValidCIDR(fldPath.Child("cidr"), string(*ipam.CIDR))...)
+	if ipam.Config != nil {
+		if ipam.Type == apiscalico.IPAMHostLocal {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("config"), fmt.Sprintf("config is only supported with IPAM type %q", apiscalico.IPAMCalico)))
+		}
+		if ipam.Config.MaxBlocksPerHost != nil && *ipam.Config.MaxBlocksPerHost < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("config", "maxBlocksPerHost"), *ipam.Config.MaxBlocksPerHost, "must be greater than or equal to 0"))
+		}
 	}
 
 	return allErrs
@@ -107,6 +183,10 @@ func ValidateNetworkConfigIPV4(ipv4 *apiscalico.IPv4, fldPath *field.Path) field
 		allErrs = append(allErrs, ValidateIPAutoDetectionMethod(*ipv4.AutoDetectionMethod, fldPath.Child("autoDetectionMethod"))...)
 	}
 
+	if ipv4.BlockSize != nil {
+		allErrs = append(allErrs, validateBlockSize(*ipv4.BlockSize, true, fldPath.Child("blockSize"))...)
+	}
+
 	return allErrs
 }
 
@@ -118,25 +198,23 @@ func ValidateNetworkConfigIPV6(ipv6 *apiscalico.IPv6, fldPath *field.Path) field
 		return allErrs
 	}
 
-	if ipv6.Pool != nil && !sets.New(apiscalico.PoolIPIP, apiscalico.PoolVXLan).Has(*ipv6.Pool) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("pool"), *ipv6.Pool, fmt.Sprintf("unsupported value %q for pool, supported values are [%q, %q]", *ipv6.Pool, apiscalico.PoolIPIP, apiscalico.PoolVXLan)))
+	// IPIP cannot encapsulate IPv6 traffic, hence the IPv6 pool is always a vxlan pool.
+	if ipv6.Pool != nil && *ipv6.Pool != apiscalico.PoolVXLan {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pool"), *ipv6.Pool, fmt.Sprintf("unsupported value %q for pool, supported values are [%q]", *ipv6.Pool, apiscalico.PoolVXLan)))
 	}
 
-	if ipv6.Mode != nil && !sets.New(apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet, apiscalico.Off).Has(*ipv6.Mode) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), *ipv6.Mode, fmt.Sprintf("unsupported value %q for mode, supported values are [%q, %q, %q, %q]", *ipv6.Mode, apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet, apiscalico.Off)))
-	}
-
-	// Check for unsupported mode with VXLan pool
-	// VXLan pool only supports Always and Never modes, so if the mode is set
-	// to CrossSubnet or Off, it is invalid.
-	if ipv6.Pool != nil && *ipv6.Pool == apiscalico.PoolVXLan && ipv6.Mode != nil && !sets.New(apiscalico.Always, apiscalico.Never).Has(*ipv6.Mode) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), *ipv6.Mode, fmt.Sprintf("unsupported value %q for mode with pool %q, supported values are [%q, %q]", *ipv6.Mode, apiscalico.PoolVXLan, apiscalico.Always, apiscalico.Never)))
+	if ipv6.Mode != nil && !sets.New(apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet).Has(*ipv6.Mode) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), *ipv6.Mode, fmt.Sprintf("unsupported value %q for mode, supported values are [%q, %q, %q]", *ipv6.Mode, apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet)))
 	}
 
 	if ipv6.AutoDetectionMethod != nil && *ipv6.AutoDetectionMethod != "" {
 		allErrs = append(allErrs, ValidateIPAutoDetectionMethod(*ipv6.AutoDetectionMethod, fldPath.Child("autoDetectionMethod"))...)
 	}
 
+	if ipv6.BlockSize != nil {
+		allErrs = append(allErrs, validateBlockSize(*ipv6.BlockSize, false, fldPath.Child("blockSize"))...)
+	}
+
 	return allErrs
 }
 
@@ -246,6 +324,443 @@ func ValidateServiceLoopPrevention(serviceLoopPrevention *apiscalico.ServiceLoop
 	return allErrs
 }
 
+// reservedASNumbers contains AS numbers which must not be used for BGP peering (RFC 4893, RFC 7300).
+var reservedASNumbers = sets.New[uint32](0, 23456, 65535, 4294967295)
+
+// routeReflectorPeerName is the name of the BGPPeer resource generated for route reflectors.
+const routeReflectorPeerName = "route-reflector"
+
+// ValidateNetworkConfigBGP validates the BGP configuration in the network config.
+func ValidateNetworkConfigBGP(bgp *apiscalico.BGP, backend *apiscalico.Backend, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if bgp == nil {
+		return allErrs
+	}
+
+	if backend != nil && *backend != apiscalico.Bird {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("BGP configuration is only supported with backend %q", apiscalico.Bird)))
+	}
+
+	if bgp.ASNumber != nil {
+		allErrs = append(allErrs, validateASNumber(*bgp.ASNumber, fldPath.Child("asNumber"))...)
+	}
+
+	if bgp.RouteReflector != nil {
+		allErrs = append(allErrs, validateSelector(bgp.RouteReflector.NodeSelector, fldPath.Child("routeReflector", "nodeSelector"))...)
+		if bgp.NodeToNodeMeshEnabled != nil && *bgp.NodeToNodeMeshEnabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeToNodeMeshEnabled"), "node-to-node mesh must not be enabled if route reflectors are configured"))
+		}
+	}
+
+	names := sets.New[string]()
+	for i, peer := range bgp.Peers {
+		idxPath := fldPath.Child("peers").Index(i)
+
+		if peer.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(peer.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), peer.Name, msg))
+			}
+			if names.Has(peer.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), peer.Name))
+			}
+			if bgp.RouteReflector != nil && peer.Name == routeReflectorPeerName {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), peer.Name, "name is reserved for the route reflector peering"))
+			}
+			names.Insert(peer.Name)
+		}
+
+		switch {
+		case peer.PeerIP == nil && peer.PeerSelector == nil:
+			allErrs = append(allErrs, field.Required(idxPath, "either peerIP or peerSelector must be set"))
+		case peer.PeerIP != nil && peer.PeerSelector != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "peerIP and peerSelector must not be set at the same time"))
+		case peer.PeerIP != nil:
+			allErrs = append(allErrs, validatePeerIP(*peer.PeerIP, ipFamilies, idxPath.Child("peerIP"))...)
+			if peer.ASNumber == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("asNumber"), "asNumber is required if peerIP is set"))
+			}
+		case peer.PeerSelector != nil:
+			allErrs = append(allErrs, validateSelector(*peer.PeerSelector, idxPath.Child("peerSelector"))...)
+			if peer.ASNumber != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("asNumber"), "asNumber must not be set if peerSelector is set"))
+			}
+		}
+
+		if peer.ASNumber != nil {
+			allErrs = append(allErrs, validateASNumber(*peer.ASNumber, idxPath.Child("asNumber"))...)
+		}
+
+		if peer.NodeSelector != nil {
+			allErrs = append(allErrs, validateSelector(*peer.NodeSelector, idxPath.Child("nodeSelector"))...)
+		}
+	}
+
+	if bgp.ServiceClusterIPs != nil {
+		allErrs = append(allErrs, validateServiceCIDRs(bgp.ServiceClusterIPs.CIDRs, ipFamilies, fldPath.Child("serviceClusterIPs", "cidrs"))...)
+	}
+	allErrs = append(allErrs, validateServiceCIDRs(bgp.ServiceExternalIPs, ipFamilies, fldPath.Child("serviceExternalIPs"))...)
+	allErrs = append(allErrs, validateServiceCIDRs(bgp.ServiceLoadBalancerIPs, ipFamilies, fldPath.Child("serviceLoadBalancerIPs"))...)
+
+	return allErrs
+}
+
+func validateServiceCIDRs(cidrs []apiscalico.CIDR, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, cidr := range cidrs {
+		idxPath := fldPath.Index(i)
+
+		ip, _, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, cidr, fmt.Sprintf("must be a valid CIDR: %v", err)))
+			continue
+		}
+
+		if len(ipFamilies) == 0 {
+			continue
+		}
+
+		ipFamily := core.IPFamilyIPv6
+		if ip.To4() != nil {
+			ipFamily = core.IPFamilyIPv4
+		}
+		if !sets.New(ipFamilies...).Has(ipFamily) {
+			allErrs = append(allErrs, field.Invalid(idxPath, cidr, fmt.Sprintf("IP family %s of the CIDR is not one of the shoot's IP families %q", ipFamily, ipFamilies)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateASNumber(asNumber uint32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if reservedASNumbers.Has(asNumber) {
+		allErrs = append(allErrs, field.Invalid(fldPath, asNumber, fmt.Sprintf("AS number must be in the range [1, 4294967294] and must not be one of the reserved AS numbers %v", sets.List(reservedASNumbers))))
+	}
+
+	return allErrs
+}
+
+func validatePeerIP(peerIP string, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	ip := net.ParseIP(peerIP)
+	if ip == nil {
+		return append(allErrs, field.Invalid(fldPath, peerIP, "must be a valid IP address"))
+	}
+
+	if len(ipFamilies) == 0 {
+		return allErrs
+	}
+
+	ipFamily := core.IPFamilyIPv6
+	if ip.To4() != nil {
+		ipFamily = core.IPFamilyIPv4
+	}
+	if !sets.New(ipFamilies...).Has(ipFamily) {
+		allErrs = append(allErrs, field.Invalid(fldPath, peerIP, fmt.Sprintf("IP family %s of the peer IP is not one of the shoot's IP families %q", ipFamily, ipFamilies)))
+	}
+
+	return allErrs
+}
+
+// validateSelector performs basic syntax checks on a calico selector expression.
+func validateSelector(selector string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strings.TrimSpace(selector) == "" {
+		return append(allErrs, field.Required(fldPath, "selector must not be empty"))
+	}
+
+	var (
+		depth      int
+		unbalanced bool
+		quote      rune
+	)
+	for _, c := range selector {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			unbalanced = unbalanced || depth < 0
+		}
+	}
+	if quote != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, selector, "selector contains an unterminated string literal"))
+	}
+	if unbalanced || depth != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, selector, "selector contains unbalanced parentheses"))
+	}
+
+	return allErrs
+}
+
+// reservedIPPoolNames contains the names of the IPPool resources created by the extension.
+var reservedIPPoolNames = sets.New("default-ipv4-ippool", "default-ipv6-ippool", "no-snat-for-node-cidr")
+
+// ValidateNetworkConfigIPPools validates the additional IP pools in the network config.
+// Overlaps with the networks of the shoot are validated by ValidateNetworkConfigAgainstShoot.
+func ValidateNetworkConfigIPPools(networkConfig *apiscalico.NetworkConfig, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(networkConfig.IPPools) == 0 {
+		return allErrs
+	}
+
+	if !usesCalicoIPAM(networkConfig, ipFamilies) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("additional IP pools are only supported with IPAM type %q", apiscalico.IPAMCalico)))
+	}
+
+	var (
+		names = sets.New[string]()
+		cidrs []*net.IPNet
+	)
+	for i, pool := range networkConfig.IPPools {
+		idxPath := fldPath.Index(i)
+
+		if pool.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(pool.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+			}
+			if names.Has(pool.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+			}
+			if reservedIPPoolNames.Has(pool.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, fmt.Sprintf("name is reserved, reserved names are %q", sets.List(reservedIPPoolNames))))
+			}
+			names.Insert(pool.Name)
+		}
+
+		allErrs = append(allErrs, validateIPPoolEncapsulation(pool, networkConfig.Backend, idxPath)...)
+
+		if pool.NodeSelector != nil {
+			allErrs = append(allErrs, validateSelector(*pool.NodeSelector, idxPath.Child("nodeSelector"))...)
+		}
+		if pool.NamespaceSelector != nil {
+			allErrs = append(allErrs, validateSelector(*pool.NamespaceSelector, idxPath.Child("namespaceSelector"))...)
+		}
+
+		allowedUses := sets.New(apiscalico.IPPoolAllowedUseWorkload, apiscalico.IPPoolAllowedUseTunnel, apiscalico.IPPoolAllowedUseLoadBalancer)
+		for j, use := range pool.AllowedUses {
+			if !allowedUses.Has(use) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("allowedUses").Index(j), use, sets.List(allowedUses)))
+			}
+		}
+
+		ip, cidr, err := net.ParseCIDR(string(pool.CIDR))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("cidr"), pool.CIDR, fmt.Sprintf("must be a valid CIDR: %v", err)))
+			continue
+		}
+
+		isIPv4 := ip.To4() != nil
+		ipFamily := core.IPFamilyIPv6
+		if isIPv4 {
+			ipFamily = core.IPFamilyIPv4
+		}
+		if len(ipFamilies) > 0 && !sets.New(ipFamilies...).Has(ipFamily) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("cidr"), pool.CIDR, fmt.Sprintf("IP family %s of the CIDR is not one of the shoot's IP families %q", ipFamily, ipFamilies)))
+		}
+
+		if pool.Encapsulation != nil && *pool.Encapsulation == apiscalico.PoolIPIP && !isIPv4 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("encapsulation"), *pool.Encapsulation, fmt.Sprintf("encapsulation %q is only supported for IPv4 pools", apiscalico.PoolIPIP)))
+		}
+
+		if pool.BlockSize != nil {
+			blockSizeErrs := validateBlockSize(*pool.BlockSize, isIPv4, idxPath.Child("blockSize"))
+			if prefixLength, _ := cidr.Mask.Size(); len(blockSizeErrs) == 0 && int(*pool.BlockSize) < prefixLength {
+				blockSizeErrs = append(blockSizeErrs, field.Invalid(idxPath.Child("blockSize"), *pool.BlockSize, fmt.Sprintf("block size must not be smaller than the prefix length %d of the CIDR", prefixLength)))
+			}
+			allErrs = append(allErrs, blockSizeErrs...)
+		}
+
+		for _, other := range cidrs {
+			if other.Contains(cidr.IP) || cidr.Contains(other.IP) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("cidr"), pool.CIDR, fmt.Sprintf("CIDR must not overlap with the CIDR %q of another IP pool", other.String())))
+			}
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigWorkerPools validates the settings of calico-node for individual worker pools.
+func ValidateNetworkConfigWorkerPools(workerPools []apiscalico.WorkerPool, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	families := sets.New(ipFamilies...)
+	names := sets.New[string]()
+	for i, workerPool := range workerPools {
+		idxPath := fldPath.Index(i)
+
+		if workerPool.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(workerPool.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), workerPool.Name, msg))
+			}
+			if names.Has(workerPool.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), workerPool.Name))
+			}
+			names.Insert(workerPool.Name)
+		}
+
+		if workerPool.IPv4AutoDetectionMethod != nil {
+			if len(ipFamilies) > 0 && !families.Has(core.IPFamilyIPv4) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("ipv4AutoDetectionMethod"), "IPv4 autodetection method must not be set if the shoot does not use IPv4"))
+			}
+			allErrs = append(allErrs, ValidateIPAutoDetectionMethod(*workerPool.IPv4AutoDetectionMethod, idxPath.Child("ipv4AutoDetectionMethod"))...)
+		}
+
+		if workerPool.IPv6AutoDetectionMethod != nil {
+			if !families.Has(core.IPFamilyIPv6) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("ipv6AutoDetectionMethod"), "IPv6 autodetection method must not be set if the shoot does not use IPv6"))
+			}
+			allErrs = append(allErrs, ValidateIPAutoDetectionMethod(*workerPool.IPv6AutoDetectionMethod, idxPath.Child("ipv6AutoDetectionMethod"))...)
+		}
+
+		if workerPool.VethMTU != nil {
+			allErrs = append(allErrs, IsValidMTU(*workerPool.VethMTU, idxPath.Child("vethMTU"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigWireguard validates the further settings of the WireGuard encryption. They must only be set if
+// the WireGuard encryption is enabled.
+func ValidateNetworkConfigWireguard(wireguard *apiscalico.Wireguard, wireguardEncryption bool, ipFamilies []core.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if wireguard == nil {
+		return allErrs
+	}
+
+	if !wireguardEncryption {
+		return append(allErrs, field.Forbidden(fldPath, "WireGuard settings must not be set if wireguardEncryption is disabled"))
+	}
+
+	if wireguard.RoutingRulePriority != nil && (*wireguard.RoutingRulePriority < 1 || *wireguard.RoutingRulePriority > 32765) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("routingRulePriority"), *wireguard.RoutingRulePriority, "must be between 1 and 32765"))
+	}
+
+	families := sets.New(ipFamilies...)
+	if wireguard.InterfaceNameV6 != nil && !families.Has(core.IPFamilyIPv6) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("interfaceNameV6"), "IPv6 interface name must not be set if the shoot does not use IPv6"))
+	}
+	if wireguard.ListeningPortV6 != nil && !families.Has(core.IPFamilyIPv6) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("listeningPortV6"), "IPv6 listening port must not be set if the shoot does not use IPv6"))
+	}
+
+	for _, name := range []struct {
+		value   *string
+		fldPath *field.Path
+	}{
+		{wireguard.InterfaceName, fldPath.Child("interfaceName")},
+		{wireguard.InterfaceNameV6, fldPath.Child("interfaceNameV6")},
+	} {
+		if name.value != nil {
+			allErrs = append(allErrs, validateInterfaceName(*name.value, name.fldPath)...)
+		}
+	}
+
+	for _, port := range []struct {
+		value   *int32
+		fldPath *field.Path
+	}{
+		{wireguard.ListeningPort, fldPath.Child("listeningPort")},
+		{wireguard.ListeningPortV6, fldPath.Child("listeningPortV6")},
+	} {
+		if port.value != nil {
+			for _, msg := range validation.IsValidPortNum(int(*port.value)) {
+				allErrs = append(allErrs, field.Invalid(port.fldPath, *port.value, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateInterfaceName checks if the given name is a valid name of a Linux network interface.
+func validateInterfaceName(name string, fldPath *field.Path) field.ErrorList {
+	if name == "" || len(name) > 15 || strings.ContainsAny(name, "/: \t\n") {
+		return field.ErrorList{field.Invalid(fldPath, name, "must be a network interface name of 1 to 15 characters without '/', ':' or whitespace")}
+	}
+	return nil
+}
+
+// usesCalicoIPAM returns true if the calico-ipam is used for the given network config of a shoot with the given IP
+// families, see calicov1alpha1helper.UsesCalicoIPAM.
+func usesCalicoIPAM(networkConfig *apiscalico.NetworkConfig, ipFamilies []core.IPFamily) bool {
+	var ipamType string
+	if networkConfig.IPAM != nil {
+		ipamType = networkConfig.IPAM.Type
+	}
+	return calicov1alpha1helper.UsesCalicoIPAM(ipamType, networkConfig.VXLAN != nil && networkConfig.VXLAN.Enabled, usesIPv4(ipFamilies))
+}
+
+// usesIPv4 returns true if the given IP families of a shoot contain IPv4, which is the default if none are set.
+func usesIPv4(ipFamilies []core.IPFamily) bool {
+	return len(ipFamilies) == 0 || slices.Contains(ipFamilies, core.IPFamilyIPv4)
+}
+
+// validateBlockSize validates the block size of an IP pool against the limits of calico.
+func validateBlockSize(blockSize int32, isIPv4 bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	minBlockSize, maxBlockSize, ipFamily := int32(116), int32(128), core.IPFamilyIPv6
+	if isIPv4 {
+		minBlockSize, maxBlockSize, ipFamily = 20, 32, core.IPFamilyIPv4
+	}
+	if blockSize < minBlockSize || blockSize > maxBlockSize {
+		allErrs = append(allErrs, field.Invalid(fldPath, blockSize, fmt.Sprintf("block size must be in the range [%d, %d] for %s pools", minBlockSize, maxBlockSize, ipFamily)))
+	}
+
+	return allErrs
+}
+
+func validateIPPoolEncapsulation(pool apiscalico.IPPool, backend *apiscalico.Backend, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if pool.Encapsulation != nil {
+		switch *pool.Encapsulation {
+		case apiscalico.PoolIPIP:
+			if backend != nil && *backend != apiscalico.Bird {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("encapsulation"), fmt.Sprintf("encapsulation %q is only supported with backend %q", apiscalico.PoolIPIP, apiscalico.Bird)))
+			}
+		case apiscalico.PoolVXLan:
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("encapsulation"), *pool.Encapsulation, fmt.Sprintf("unsupported value %q for encapsulation, supported values are [%q, %q]", *pool.Encapsulation, apiscalico.PoolIPIP, apiscalico.PoolVXLan)))
+		}
+	}
+
+	if pool.Mode != nil {
+		allowedModes := sets.New(apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet)
+		if !allowedModes.Has(*pool.Mode) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), *pool.Mode, fmt.Sprintf("unsupported value %q for mode, supported values are [%q, %q, %q]", *pool.Mode, apiscalico.Always, apiscalico.Never, apiscalico.CrossSubnet)))
+		} else if pool.Encapsulation != nil && *pool.Encapsulation == apiscalico.PoolVXLan && *pool.Mode == apiscalico.CrossSubnet {
+			// Same restriction as for the default pools, see ValidateNetworkConfigIPV4.
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), *pool.Mode, fmt.Sprintf("unsupported value %q for mode with encapsulation %q, supported values are [%q, %q]", *pool.Mode, apiscalico.PoolVXLan, apiscalico.Always, apiscalico.Never)))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateResourceList validates the resources in the resource list.
 // It checks if the CPU and memory resources are specified and not zero, and if any unsupported resources are present.
 func ValidateResourceList(resourceList *v1.ResourceList, fldPath *field.Path) field.ErrorList {
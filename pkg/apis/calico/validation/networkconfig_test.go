@@ -41,10 +41,14 @@ var _ = Describe("Network validation", func() {
 			BeEmpty()),
 		Entry("should succeed with calico-ipam for IPv4 single-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "calico-ipam"}, IPv4: &apiscalico.IPv4{}}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
 			BeEmpty()),
-		Entry("should return error with calico-ipam for IPv6 single-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "calico-ipam"}, IPv6: &apiscalico.IPv6{}}, []core.IPFamily{core.IPFamilyIPv6}, field.NewPath("config"),
-			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipam.type")})))),
-		Entry("should return error with calico-ipam for dual-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "calico-ipam"}, IPv4: &apiscalico.IPv4{}, IPv6: &apiscalico.IPv6{}}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
-			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipam.type")})))),
+		Entry("should succeed with calico-ipam for IPv6 single-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "calico-ipam"}, IPv6: &apiscalico.IPv6{}}, []core.IPFamily{core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should succeed with calico-ipam for dual-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "calico-ipam"}, IPv4: &apiscalico.IPv4{}, IPv6: &apiscalico.IPv6{}}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should succeed with an IPAM CIDR of the IP family of the shoot", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "host-local", CIDR: ptr.To(apiscalico.CIDR("2001:db8::/64"))}}, []core.IPFamily{core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with an IPAM CIDR of another IP family than the shoot", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "host-local", CIDR: ptr.To(apiscalico.CIDR("10.0.0.0/16"))}}, []core.IPFamily{core.IPFamilyIPv6}, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipam.cidr")})))),
 		Entry("should succeed with host-local for dual-stack", &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: "host-local"}, IPv4: &apiscalico.IPv4{}, IPv6: &apiscalico.IPv6{}}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
 			BeEmpty()),
 		Entry("should return error with invalid IPv4 pool mode", &apiscalico.NetworkConfig{IPv4: &apiscalico.IPv4{Mode: ptr.To(apiscalico.PoolMode("invalid"))}}, nil, field.NewPath("config"),
@@ -59,14 +63,18 @@ var _ = Describe("Network validation", func() {
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv4.mode")})))),
 		Entry("should succeed with pool mode VXLAN and valid mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Pool: ptr.To(apiscalico.PoolVXLan), Mode: ptr.To(apiscalico.Never)}}, nil, field.NewPath("config"),
 			BeEmpty()),
-		Entry("should return error with pool mode VXLAN and invalid mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Pool: ptr.To(apiscalico.PoolVXLan), Mode: ptr.To(apiscalico.CrossSubnet)}}, nil, field.NewPath("config"),
+		Entry("should succeed with pool mode VXLAN and mode CrossSubnet", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Pool: ptr.To(apiscalico.PoolVXLan), Mode: ptr.To(apiscalico.CrossSubnet)}}, nil, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with pool mode VXLAN and invalid mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Pool: ptr.To(apiscalico.PoolVXLan), Mode: ptr.To(apiscalico.Off)}}, nil, field.NewPath("config"),
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv6.mode")})))),
 		Entry("should return error with invalid IPv6 pool mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Mode: ptr.To(apiscalico.PoolMode("invalid"))}}, nil, field.NewPath("config"),
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv6.mode")})))),
 		Entry("should return error with invalid IPv6 pool", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Pool: ptr.To(apiscalico.Pool("geneve"))}}, nil, field.NewPath("config"),
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv6.pool")})))),
-		Entry("should succeed with valid IPv6 pool mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Mode: ptr.To(apiscalico.Always), Pool: ptr.To(apiscalico.PoolIPIP)}}, nil, field.NewPath("config"),
+		Entry("should succeed with valid IPv6 pool mode", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Mode: ptr.To(apiscalico.Always), Pool: ptr.To(apiscalico.PoolVXLan)}}, nil, field.NewPath("config"),
 			BeEmpty()),
+		Entry("should return error with IPv6 pool IPIP", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{Mode: ptr.To(apiscalico.Always), Pool: ptr.To(apiscalico.PoolIPIP)}}, nil, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv6.pool")})))),
 		Entry("should succeed with a valid IPv6 autodetection method", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{AutoDetectionMethod: ptr.To("interface=cali1234")}}, nil, field.NewPath("config"),
 			BeEmpty()),
 		Entry("should return error with invalid IPv6 autodetection method", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{AutoDetectionMethod: ptr.To("invalid-method")}}, nil, field.NewPath("config"),
@@ -192,5 +200,337 @@ var _ = Describe("Network validation", func() {
 			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.autoScaling.resources.typha.memory"), "Detail": ContainSubstring("must be positive")})),
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.autoScaling.resources.typha.memory"), "Detail": ContainSubstring("must be greater than or equal to 0")}))),
 		),
+		Entry("should succeed with valid BGP config", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				ASNumber:              ptr.To[uint32](64512),
+				NodeToNodeMeshEnabled: ptr.To(false),
+				Peers: []apiscalico.BGPPeer{
+					{Name: "tor-router", PeerIP: ptr.To("10.250.0.1"), ASNumber: ptr.To[uint32](64513), NodeSelector: ptr.To("rack == 'rack-1'")},
+					{Name: "tor-router-v6", PeerIP: ptr.To("2001:db8::1"), ASNumber: ptr.To[uint32](4200000000)},
+					{Name: "in-cluster", PeerSelector: ptr.To("has(bgp-peer)")},
+				},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should succeed with valid BGP route reflector config", &apiscalico.NetworkConfig{
+			Backend: ptr.To(apiscalico.Bird),
+			BGP: &apiscalico.BGP{
+				RouteReflector: &apiscalico.BGPRouteReflector{NodeSelector: "route-reflector == 'true'"},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with BGP config and non-bird backend", &apiscalico.NetworkConfig{
+			Backend: ptr.To(apiscalico.VXLan),
+			BGP:     &apiscalico.BGP{},
+		}, nil, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.bgp")})))),
+		Entry("should return error with reserved AS numbers", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				ASNumber: ptr.To[uint32](0),
+				Peers: []apiscalico.BGPPeer{
+					{Name: "tor-router", PeerIP: ptr.To("10.250.0.1"), ASNumber: ptr.To[uint32](23456)},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.asNumber")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.peers[0].asNumber")})),
+			)),
+		Entry("should return error with invalid or duplicate BGP peer names", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "", PeerSelector: ptr.To("all()")},
+					{Name: "Invalid_Name", PeerSelector: ptr.To("all()")},
+					{Name: "peer", PeerSelector: ptr.To("all()")},
+					{Name: "peer", PeerSelector: ptr.To("all()")},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.bgp.peers[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.bgp.peers[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("config.bgp.peers[3].name")})),
+			)),
+		Entry("should return error with BGP peer names reserved for route reflectors", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				RouteReflector: &apiscalico.BGPRouteReflector{NodeSelector: "route-reflector == 'true'"},
+				Peers: []apiscalico.BGPPeer{
+					{Name: "route-reflector", PeerSelector: ptr.To("all()")},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.peers[0].name")})))),
+		Entry("should return error if neither or both of peerIP and peerSelector are set", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "neither"},
+					{Name: "both", PeerIP: ptr.To("10.250.0.1"), ASNumber: ptr.To[uint32](64513), PeerSelector: ptr.To("all()")},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.bgp.peers[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.bgp.peers[1]")})),
+			)),
+		Entry("should return error with invalid peer IP or missing peer AS number", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "invalid-ip", PeerIP: ptr.To("10.250.0.300"), ASNumber: ptr.To[uint32](64513)},
+					{Name: "missing-asn", PeerIP: ptr.To("10.250.0.1")},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.peers[0].peerIP")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.bgp.peers[1].asNumber")})),
+			)),
+		Entry("should return error with peer IP of an IP family not used by the shoot", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "tor-router-v6", PeerIP: ptr.To("2001:db8::1"), ASNumber: ptr.To[uint32](64513)},
+				},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.peers[0].peerIP")})))),
+		Entry("should return error with AS number for peers selected by peerSelector", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "in-cluster", PeerSelector: ptr.To("has(bgp-peer)"), ASNumber: ptr.To[uint32](64513)},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.bgp.peers[0].asNumber")})))),
+		Entry("should return error with invalid selectors", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				Peers: []apiscalico.BGPPeer{
+					{Name: "unbalanced", PeerSelector: ptr.To("has(bgp-peer"), NodeSelector: ptr.To(" ")},
+					{Name: "unterminated", PeerSelector: ptr.To("rack == 'rack-1")},
+				},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.bgp.peers[0].peerSelector")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.bgp.peers[0].nodeSelector")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.bgp.peers[1].peerSelector")})),
+			)),
+		Entry("should succeed with valid BGP service advertisement", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				ServiceClusterIPs:      &apiscalico.BGPServiceClusterIPs{},
+				ServiceExternalIPs:     []apiscalico.CIDR{"192.0.2.0/24"},
+				ServiceLoadBalancerIPs: []apiscalico.CIDR{"198.51.100.0/24"},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with invalid BGP service advertisement CIDRs", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				ServiceClusterIPs:      &apiscalico.BGPServiceClusterIPs{CIDRs: []apiscalico.CIDR{"100.64.0.0"}},
+				ServiceExternalIPs:     []apiscalico.CIDR{"192.0.2.0/24", "2001:db8::/64"},
+				ServiceLoadBalancerIPs: []apiscalico.CIDR{"foo"},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceClusterIPs.cidrs[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceExternalIPs[1]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceLoadBalancerIPs[0]")})),
+			)),
+		Entry("should return error with route reflectors and enabled node-to-node mesh", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{
+				NodeToNodeMeshEnabled: ptr.To(true),
+				RouteReflector:        &apiscalico.BGPRouteReflector{NodeSelector: ")route-reflector == 'true'("},
+			},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.routeReflector.nodeSelector")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.bgp.nodeToNodeMeshEnabled")})),
+			)),
+		Entry("should succeed with valid block sizes and IPAM config", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{
+				Type:   apiscalico.IPAMCalico,
+				Config: &apiscalico.IPAMConfig{StrictAffinity: ptr.To(true), MaxBlocksPerHost: ptr.To[int32](4), AutoAllocateBlocks: ptr.To(true)},
+			},
+			IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](24)},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with invalid block sizes", &apiscalico.NetworkConfig{
+			IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](33)},
+			IPv6: &apiscalico.IPv6{BlockSize: ptr.To[int32](64)},
+		}, nil, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipv4.blockSize")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipv6.blockSize")})),
+			)),
+		Entry("should return error with invalid IPAM config", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{
+				Type:   apiscalico.IPAMHostLocal,
+				Config: &apiscalico.IPAMConfig{MaxBlocksPerHost: ptr.To[int32](-1)},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.config")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipam.config.maxBlocksPerHost")})),
+			)),
+		Entry("should succeed with valid additional IP pools", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico},
+			IPPools: []apiscalico.IPPool{
+				{
+					Name:              "tenant-a",
+					CIDR:              "172.16.0.0/16",
+					BlockSize:         ptr.To[int32](24),
+					Encapsulation:     ptr.To(apiscalico.PoolVXLan),
+					Mode:              ptr.To(apiscalico.Always),
+					NodeSelector:      ptr.To("worker.gardener.cloud/pool == 'tenant-a'"),
+					NamespaceSelector: ptr.To("tenant == 'a'"),
+					AllowedUses:       []apiscalico.IPPoolAllowedUse{apiscalico.IPPoolAllowedUseWorkload},
+				},
+				{Name: "load-balancers", CIDR: "172.17.0.0/24", AllowedUses: []apiscalico.IPPoolAllowedUse{apiscalico.IPPoolAllowedUseLoadBalancer}},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with additional IP pools without calico-ipam", &apiscalico.NetworkConfig{
+			IPAM:    &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal},
+			IPPools: []apiscalico.IPPool{{Name: "tenant-a", CIDR: "172.16.0.0/16"}},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipPools")})))),
+		Entry("should return error with invalid, duplicate or reserved IP pool names", &apiscalico.NetworkConfig{
+			VXLAN: &apiscalico.VXLAN{Enabled: true},
+			IPPools: []apiscalico.IPPool{
+				{CIDR: "172.16.0.0/24"},
+				{Name: "Tenant_A", CIDR: "172.16.1.0/24"},
+				{Name: "tenant-a", CIDR: "172.16.2.0/24"},
+				{Name: "tenant-a", CIDR: "172.16.3.0/24"},
+				{Name: "default-ipv4-ippool", CIDR: "172.16.4.0/24"},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.ipPools[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("config.ipPools[3].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[4].name")})),
+			)),
+		Entry("should return error with invalid, overlapping or foreign family IP pool CIDRs", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico},
+			IPPools: []apiscalico.IPPool{
+				{Name: "invalid", CIDR: "172.16.0.0"},
+				{Name: "a", CIDR: "172.16.0.0/16"},
+				{Name: "b", CIDR: "172.16.128.0/20"},
+				{Name: "ipv6", CIDR: "2001:db8::/64"},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[0].cidr")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[2].cidr")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[3].cidr")})),
+			)),
+		Entry("should return error with invalid IP pool block sizes", &apiscalico.NetworkConfig{
+			VXLAN: &apiscalico.VXLAN{Enabled: true},
+			IPPools: []apiscalico.IPPool{
+				{Name: "too-small", CIDR: "172.16.0.0/16", BlockSize: ptr.To[int32](16)},
+				{Name: "larger-than-cidr", CIDR: "172.17.0.0/24", BlockSize: ptr.To[int32](22)},
+				{Name: "ipv6", CIDR: "2001:db8::/64", BlockSize: ptr.To[int32](26)},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[0].blockSize")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[1].blockSize")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[2].blockSize")})),
+			)),
+		Entry("should return error with invalid IP pool encapsulation, modes, selectors and allowed uses", &apiscalico.NetworkConfig{
+			Backend: ptr.To(apiscalico.VXLan),
+			VXLAN:   &apiscalico.VXLAN{Enabled: true},
+			IPPools: []apiscalico.IPPool{
+				{Name: "ipip", CIDR: "172.16.0.0/24", Encapsulation: ptr.To(apiscalico.PoolIPIP)},
+				{Name: "foo", CIDR: "172.16.1.0/24", Encapsulation: ptr.To(apiscalico.Pool("foo")), Mode: ptr.To(apiscalico.Off)},
+				{Name: "vxlan", CIDR: "172.16.2.0/24", Encapsulation: ptr.To(apiscalico.PoolVXLan), Mode: ptr.To(apiscalico.CrossSubnet)},
+				{Name: "selectors", CIDR: "172.16.3.0/24", NodeSelector: ptr.To(""), NamespaceSelector: ptr.To("tenant == 'a")},
+				{Name: "uses", CIDR: "172.16.4.0/24", AllowedUses: []apiscalico.IPPoolAllowedUse{apiscalico.IPPoolAllowedUseWorkload, "Foo"}},
+				{Name: "ipip-ipv6", CIDR: "2001:db8::/64", Encapsulation: ptr.To(apiscalico.PoolIPIP)},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipPools[0].encapsulation")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[1].encapsulation")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[1].mode")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[2].mode")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.ipPools[3].nodeSelector")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[3].namespaceSelector")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("config.ipPools[4].allowedUses[1]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipPools[5].encapsulation")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.ipPools[5].encapsulation")})),
+			)),
+		Entry("should succeed with valid worker pool settings", &apiscalico.NetworkConfig{
+			WorkerPools: []apiscalico.WorkerPool{
+				{Name: "ebpf", EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: true}},
+				{Name: "jumbo", IPv4AutoDetectionMethod: ptr.To("interface=eth1"), IPv6AutoDetectionMethod: ptr.To("cidr=2001:db8::/32"), VethMTU: ptr.To("8950")},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with invalid worker pool settings", &apiscalico.NetworkConfig{
+			WorkerPools: []apiscalico.WorkerPool{
+				{Name: ""},
+				{Name: "Pool_A", VethMTU: ptr.To("-1")},
+				{Name: "pool-b", IPv4AutoDetectionMethod: ptr.To("interface")},
+				{Name: "pool-b", IPv6AutoDetectionMethod: ptr.To("first-found")},
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("config.workerPools[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.workerPools[1].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.workerPools[1].vethMTU")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.workerPools[2].ipv4AutoDetectionMethod")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("config.workerPools[3].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.workerPools[3].ipv6AutoDetectionMethod")})),
+			)),
+		Entry("should succeed with valid wireguard settings", &apiscalico.NetworkConfig{
+			WireguardEncryption: true,
+			Wireguard: &apiscalico.Wireguard{
+				HostEncryptionEnabled: ptr.To(true),
+				RoutingRulePriority:   ptr.To[int32](100),
+				InterfaceName:         ptr.To("wg0"),
+				InterfaceNameV6:       ptr.To("wg1"),
+				ListeningPort:         ptr.To[int32](51830),
+				ListeningPortV6:       ptr.To[int32](51831),
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}, field.NewPath("config"),
+			BeEmpty()),
+		Entry("should return error with wireguard settings if wireguard encryption is disabled", &apiscalico.NetworkConfig{
+			Wireguard: &apiscalico.Wireguard{HostEncryptionEnabled: ptr.To(true)},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.wireguard")})))),
+		Entry("should return error with invalid wireguard settings", &apiscalico.NetworkConfig{
+			WireguardEncryption: true,
+			Wireguard: &apiscalico.Wireguard{
+				RoutingRulePriority: ptr.To[int32](0),
+				InterfaceName:       ptr.To("wireguard-interface"),
+				InterfaceNameV6:     ptr.To("wg1"),
+				ListeningPort:       ptr.To[int32](70000),
+			},
+		}, []core.IPFamily{core.IPFamilyIPv4}, field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.wireguard.routingRulePriority")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.wireguard.interfaceNameV6")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.wireguard.interfaceName")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("config.wireguard.listeningPort")})),
+			)),
+	)
+
+	DescribeTable("#ValidateNetworkConfigUpdate",
+		func(oldNetworkConfig, networkConfig *apiscalico.NetworkConfig, oldIPFamilies []core.IPFamily, matcher gomegatypes.GomegaMatcher) {
+			Expect(validation.ValidateNetworkConfigUpdate(oldNetworkConfig, networkConfig, oldIPFamilies, field.NewPath("config"))).To(matcher)
+		},
+
+		Entry("should succeed without changes", &apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{}, []core.IPFamily{core.IPFamilyIPv4},
+			BeEmpty()),
+		Entry("should succeed if the default block size is set explicitly", &apiscalico.NetworkConfig{},
+			&apiscalico.NetworkConfig{IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](26)}}, []core.IPFamily{core.IPFamilyIPv4},
+			BeEmpty()),
+		Entry("should return error if the block size is changed", &apiscalico.NetworkConfig{IPv6: &apiscalico.IPv6{BlockSize: ptr.To[int32](120)}},
+			&apiscalico.NetworkConfig{IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](24)}}, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipv4.blockSize")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipv6.blockSize")})),
+			)),
+		Entry("should succeed if the block size of a newly added IP family is set", &apiscalico.NetworkConfig{},
+			&apiscalico.NetworkConfig{IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](24)}}, []core.IPFamily{core.IPFamilyIPv6},
+			BeEmpty()),
 	)
 })
@@ -10,8 +10,10 @@ import (
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
 
@@ -52,3 +54,209 @@ func ValidateNetworking(networking *core.Networking, fldPath *field.Path) field.
 
 	return allErrs
 }
+
+// ValidateShootAnnotations validates the annotations of a Shoot which are handled by the extension.
+func ValidateShootAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// The identifier of the WireGuard key rotation is rendered into the calico-node pods and stored on the nodes.
+	if keyRotation, ok := annotations[calico.AnnotationWireguardKeyRotation]; ok {
+		for _, msg := range validation.IsValidLabelValue(keyRotation) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(calico.AnnotationWireguardKeyRotation), keyRotation, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNetworkConfigAgainstShoot validates the network config against the networking settings of the given Shoot.
+func ValidateNetworkConfigAgainstShoot(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if networkConfig.BGP != nil && networkConfig.BGP.ServiceClusterIPs != nil {
+		allErrs = append(allErrs, validateServiceClusterIPsAgainstShoot(networkConfig.BGP.ServiceClusterIPs.CIDRs, shoot, fldPath.Child("bgp", "serviceClusterIPs", "cidrs"))...)
+	}
+
+	allErrs = append(allErrs, validateIPPoolsAgainstShoot(networkConfig.IPPools, shoot, fldPath.Child("ipPools"))...)
+
+	allErrs = append(allErrs, validateWorkerPoolsAgainstShoot(networkConfig.WorkerPools, shoot, fldPath.Child("workerPools"))...)
+
+	if usesCalicoIPAM(networkConfig, shootIPFamilies(shoot)) {
+		allErrs = append(allErrs, validateBlockSizesAgainstShoot(networkConfig, shoot, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// shootIPFamilies returns the IP families of the given Shoot.
+func shootIPFamilies(shoot *core.Shoot) []core.IPFamily {
+	if shoot.Spec.Networking == nil {
+		return nil
+	}
+	return shoot.Spec.Networking.IPFamilies
+}
+
+func validateServiceClusterIPsAgainstShoot(cidrs []apiscalico.CIDR, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	serviceCIDRs := parseCIDRs(shootServiceCIDRs(shoot))
+	if len(serviceCIDRs) == 0 {
+		// the service range of the shoot is not known (yet)
+		return allErrs
+	}
+
+	for i, cidr := range cidrs {
+		_, clusterIPCIDR, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			// invalid CIDRs are already reported by ValidateNetworkConfig
+			continue
+		}
+		if !isSubnetOfAny(clusterIPCIDR, serviceCIDRs) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, fmt.Sprintf("CIDR must be within the service range of the shoot %q", shootServiceCIDRs(shoot))))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIPPoolsAgainstShoot(pools []apiscalico.IPPool, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pool := range pools {
+		_, poolCIDR, err := net.ParseCIDR(string(pool.CIDR))
+		if err != nil {
+			// invalid CIDRs are already reported by ValidateNetworkConfig
+			continue
+		}
+
+		for _, network := range []struct {
+			name  string
+			cidrs []string
+		}{
+			{name: "pod", cidrs: shootPodCIDRs(shoot)},
+			{name: "node", cidrs: shootNodeCIDRs(shoot)},
+			{name: "service", cidrs: shootServiceCIDRs(shoot)},
+		} {
+			for _, cidr := range parseCIDRs(network.cidrs) {
+				if cidr.Contains(poolCIDR.IP) || poolCIDR.Contains(cidr.IP) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("cidr"), pool.CIDR, fmt.Sprintf("CIDR must not overlap with the %s CIDR %q of the shoot", network.name, cidr.String())))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerPoolsAgainstShoot checks that the worker pools exist in the shoot. The eBPF dataplane must not be
+// disabled for a worker pool if kube-proxy is disabled, as the services would not be reachable on its nodes.
+func validateWorkerPoolsAgainstShoot(workerPools []apiscalico.WorkerPool, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	workers := sets.New[string]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workers.Insert(worker.Name)
+	}
+	kubeProxyDisabled := shoot.Spec.Kubernetes.KubeProxy != nil && shoot.Spec.Kubernetes.KubeProxy.Enabled != nil && !*shoot.Spec.Kubernetes.KubeProxy.Enabled
+
+	for i, workerPool := range workerPools {
+		if !workers.Has(workerPool.Name) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(i).Child("name"), workerPool.Name))
+		}
+		if kubeProxyDisabled && workerPool.EbpfDataplane != nil && !workerPool.EbpfDataplane.Enabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("ebpfDataplane", "enabled"), "eBPF dataplane must not be disabled for a worker pool if kube-proxy is disabled"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateBlockSizesAgainstShoot checks that the pod CIDRs of the shoot contain at least one address block
+// of the default IP pools for each node the worker pools of the shoot may scale up to.
+func validateBlockSizesAgainstShoot(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var maxNodes int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int64(worker.Maximum)
+	}
+
+	for _, podCIDR := range parseCIDRs(shootPodCIDRs(shoot)) {
+		blockSize, blockSizePath := int32(122), fldPath.Child("ipv6", "blockSize")
+		if podCIDR.IP.To4() != nil {
+			blockSize, blockSizePath = 26, fldPath.Child("ipv4", "blockSize")
+			if networkConfig.IPv4 != nil && networkConfig.IPv4.BlockSize != nil {
+				blockSize = *networkConfig.IPv4.BlockSize
+			}
+		} else if networkConfig.IPv6 != nil && networkConfig.IPv6.BlockSize != nil {
+			blockSize = *networkConfig.IPv6.BlockSize
+		}
+
+		prefixLength, _ := podCIDR.Mask.Size()
+		if int(blockSize) < prefixLength {
+			allErrs = append(allErrs, field.Invalid(blockSizePath, blockSize, fmt.Sprintf("block size must not be smaller than the prefix length %d of the pod CIDR %q", prefixLength, podCIDR.String())))
+			continue
+		}
+		if int(blockSize)-prefixLength >= 32 {
+			// more blocks than nodes a shoot can have
+			continue
+		}
+		if blocks := int64(1) << (int(blockSize) - prefixLength); blocks < maxNodes {
+			allErrs = append(allErrs, field.Invalid(blockSizePath, blockSize, fmt.Sprintf("block size allows at most %d nodes in the pod CIDR %q, but the worker pools of the shoot may scale up to %d nodes", blocks, podCIDR.String(), maxNodes)))
+		}
+	}
+
+	return allErrs
+}
+
+func shootPodCIDRs(shoot *core.Shoot) []string {
+	if shoot.Status.Networking != nil && len(shoot.Status.Networking.Pods) > 0 {
+		return shoot.Status.Networking.Pods
+	}
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Pods != nil {
+		return []string{*shoot.Spec.Networking.Pods}
+	}
+	return nil
+}
+
+func shootNodeCIDRs(shoot *core.Shoot) []string {
+	if shoot.Status.Networking != nil && len(shoot.Status.Networking.Nodes) > 0 {
+		return shoot.Status.Networking.Nodes
+	}
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Nodes != nil {
+		return []string{*shoot.Spec.Networking.Nodes}
+	}
+	return nil
+}
+
+func shootServiceCIDRs(shoot *core.Shoot) []string {
+	if shoot.Status.Networking != nil && len(shoot.Status.Networking.Services) > 0 {
+		return shoot.Status.Networking.Services
+	}
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Services != nil {
+		return []string{*shoot.Spec.Networking.Services}
+	}
+	return nil
+}
+
+// parseCIDRs parses the given CIDRs and skips invalid ones, which are reported by ValidateNetworking.
+func parseCIDRs(cidrs []string) []*net.IPNet {
+	var result []*net.IPNet
+	for _, cidr := range cidrs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			result = append(result, ipNet)
+		}
+	}
+	return result
+}
+
+func isSubnetOfAny(subnet *net.IPNet, cidrs []*net.IPNet) bool {
+	subnetOnes, subnetBits := subnet.Mask.Size()
+	for _, cidr := range cidrs {
+		ones, bits := cidr.Mask.Size()
+		if subnetBits == bits && subnetOnes >= ones && cidr.Contains(subnet.IP) {
+			return true
+		}
+	}
+	return false
+}
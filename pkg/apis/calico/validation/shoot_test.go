@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -13,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
@@ -59,4 +62,127 @@ var _ = Describe("Shoot validation", func() {
 		Entry("should succeed for dual-stack (v6/v4", &core.Networking{Type: ptr.To(calico.Type), IPFamilies: []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4}},
 			BeEmpty()),
 	)
+
+	DescribeTable("#ValidateShootAnnotations",
+		func(annotations map[string]string, matcher gomegatypes.GomegaMatcher) {
+			Expect(validation.ValidateShootAnnotations(annotations, field.NewPath("metadata", "annotations"))).To(matcher)
+		},
+
+		Entry("should succeed without annotations", nil,
+			BeEmpty()),
+		Entry("should succeed for a valid WireGuard key rotation", map[string]string{calico.AnnotationWireguardKeyRotation: "2026-10-18"},
+			BeEmpty()),
+		Entry("should return error for an invalid WireGuard key rotation", map[string]string{calico.AnnotationWireguardKeyRotation: "$(reboot)"},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("metadata.annotations[" + calico.AnnotationWireguardKeyRotation + "]")})))),
+		Entry("should return error for a too long WireGuard key rotation", map[string]string{calico.AnnotationWireguardKeyRotation: fmt.Sprintf("%064d", 0)},
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("metadata.annotations[" + calico.AnnotationWireguardKeyRotation + "]")})))),
+	)
+
+	DescribeTable("#ValidateNetworkConfigAgainstShoot",
+		func(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot, matcher gomegatypes.GomegaMatcher) {
+			Expect(validation.ValidateNetworkConfigAgainstShoot(networkConfig, shoot, field.NewPath("config"))).To(matcher)
+		},
+
+		Entry("should succeed without BGP config", &apiscalico.NetworkConfig{}, shootWithServices(ptr.To("100.64.0.0/13"), nil),
+			BeEmpty()),
+		Entry("should succeed with service cluster IP CIDRs within the service range", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{ServiceClusterIPs: &apiscalico.BGPServiceClusterIPs{CIDRs: []apiscalico.CIDR{"100.64.0.0/13", "100.64.1.0/24"}}},
+		}, shootWithServices(ptr.To("100.64.0.0/13"), nil),
+			BeEmpty()),
+		Entry("should return error with service cluster IP CIDRs outside of the service range", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{ServiceClusterIPs: &apiscalico.BGPServiceClusterIPs{CIDRs: []apiscalico.CIDR{"100.64.1.0/24", "100.0.0.0/8", "10.0.0.0/24"}}},
+		}, shootWithServices(ptr.To("100.64.0.0/13"), nil),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceClusterIPs.cidrs[1]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceClusterIPs.cidrs[2]")})),
+			)),
+		Entry("should use the service ranges from the shoot status", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{ServiceClusterIPs: &apiscalico.BGPServiceClusterIPs{CIDRs: []apiscalico.CIDR{"100.64.1.0/24", "2001:db8:1::/112", "2001:db8:2::/112"}}},
+		}, shootWithServices(ptr.To("100.64.0.0/13"), []string{"100.64.0.0/13", "2001:db8:1::/108"}),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.bgp.serviceClusterIPs.cidrs[2]")})))),
+		Entry("should succeed if the service range of the shoot is unknown", &apiscalico.NetworkConfig{
+			BGP: &apiscalico.BGP{ServiceClusterIPs: &apiscalico.BGPServiceClusterIPs{CIDRs: []apiscalico.CIDR{"10.0.0.0/24"}}},
+		}, shootWithServices(nil, nil),
+			BeEmpty()),
+		Entry("should succeed with IP pools not overlapping with the shoot networks", &apiscalico.NetworkConfig{
+			IPPools: []apiscalico.IPPool{{Name: "tenant-a", CIDR: "172.16.0.0/16"}},
+		}, shootWithNetworks(ptr.To("100.96.0.0/11"), ptr.To("10.250.0.0/16"), ptr.To("100.64.0.0/13")),
+			BeEmpty()),
+		Entry("should return error with IP pools overlapping with the shoot networks", &apiscalico.NetworkConfig{
+			IPPools: []apiscalico.IPPool{
+				{Name: "pods", CIDR: "100.96.0.0/16"},
+				{Name: "nodes", CIDR: "10.0.0.0/8"},
+				{Name: "services", CIDR: "100.64.1.0/24"},
+			},
+		}, shootWithNetworks(ptr.To("100.96.0.0/11"), ptr.To("10.250.0.0/16"), ptr.To("100.64.0.0/13")),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[0].cidr"), "Detail": ContainSubstring("pod CIDR")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[1].cidr"), "Detail": ContainSubstring("node CIDR")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipPools[2].cidr"), "Detail": ContainSubstring("service CIDR")})),
+			)),
+		Entry("should succeed if the pod CIDR fits the maximum number of nodes", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico},
+		}, shootWithWorkers(ptr.To("100.96.0.0/16"), 512, 512),
+			BeEmpty()),
+		Entry("should return error if the pod CIDR does not fit the maximum number of nodes", &apiscalico.NetworkConfig{
+			IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico},
+			IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](24)},
+		}, shootWithWorkers(ptr.To("100.96.0.0/16"), 200, 100),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv4.blockSize"), "Detail": ContainSubstring("at most 256 nodes")})))),
+		Entry("should return error if the block size is smaller than the prefix length of the pod CIDR", &apiscalico.NetworkConfig{
+			VXLAN: &apiscalico.VXLAN{Enabled: true},
+			IPv4:  &apiscalico.IPv4{BlockSize: ptr.To[int32](20)},
+		}, shootWithWorkers(ptr.To("100.96.0.0/24"), 1),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.ipv4.blockSize")})))),
+		Entry("should not check the maximum number of nodes with host-local IPAM", &apiscalico.NetworkConfig{
+			IPv4: &apiscalico.IPv4{BlockSize: ptr.To[int32](24)},
+		}, shootWithWorkers(ptr.To("100.96.0.0/16"), 200, 100),
+			BeEmpty()),
+		Entry("should succeed with worker pool settings for existing worker pools", &apiscalico.NetworkConfig{
+			WorkerPools: []apiscalico.WorkerPool{{Name: "worker-1", VethMTU: ptr.To("8950")}},
+		}, shootWithWorkers(nil, 2, 2),
+			BeEmpty()),
+		Entry("should return error with worker pool settings for unknown worker pools", &apiscalico.NetworkConfig{
+			WorkerPools: []apiscalico.WorkerPool{{Name: "worker-0"}, {Name: "worker-2"}},
+		}, shootWithWorkers(nil, 2, 2),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotFound), "Field": Equal("config.workerPools[1].name")})))),
+		Entry("should return error if the eBPF dataplane is disabled for a worker pool without kube-proxy", &apiscalico.NetworkConfig{
+			EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: true},
+			WorkerPools:   []apiscalico.WorkerPool{{Name: "worker-0", EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: false}}},
+		}, withoutKubeProxy(shootWithWorkers(nil, 2)),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.workerPools[0].ebpfDataplane.enabled")})))),
+	)
 })
+
+func shootWithWorkers(pods *string, maximums ...int32) *core.Shoot {
+	shoot := shootWithNetworks(pods, nil, nil)
+	for i, maximum := range maximums {
+		shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, core.Worker{Name: fmt.Sprintf("worker-%d", i), Maximum: maximum})
+	}
+	return shoot
+}
+
+func withoutKubeProxy(shoot *core.Shoot) *core.Shoot {
+	shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{Enabled: ptr.To(false)}
+	return shoot
+}
+
+func shootWithServices(services *string, statusServices []string) *core.Shoot {
+	shoot := &core.Shoot{
+		Spec: core.ShootSpec{
+			Networking: &core.Networking{Type: ptr.To(calico.Type), Services: services},
+		},
+	}
+	if statusServices != nil {
+		shoot.Status.Networking = &core.NetworkingStatus{Services: statusServices}
+	}
+	return shoot
+}
+
+func shootWithNetworks(pods, nodes, services *string) *core.Shoot {
+	return &core.Shoot{
+		Spec: core.ShootSpec{
+			Networking: &core.Networking{Type: ptr.To(calico.Type), Pods: pods, Nodes: nodes, Services: services},
+		},
+	}
+}
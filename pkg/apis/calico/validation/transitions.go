@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+// transitionPolicy defines how a change of a network config setting is treated when a shoot is updated.
+type transitionPolicy int
+
+const (
+	// transitionWarn accepts the change but returns a warning, as it causes a short disruption.
+	transitionWarn transitionPolicy = iota
+	// transitionRequiresOptIn accepts the change only if the shoot is annotated with
+	// calico.AnnotationAllowDisruptiveUpdate.
+	transitionRequiresOptIn
+	// transitionForbidden rejects the change.
+	transitionForbidden
+)
+
+// transition describes a setting of the network config whose changes are subject to the transition matrix.
+type transition struct {
+	// path is the path of the setting relative to the provider config.
+	path []string
+	// value returns the effective value of the setting for the given network config and shoot. An empty value means
+	// that the setting does not apply to the shoot, changes from or to it are not subject to the transition matrix.
+	value func(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot) string
+	// policy returns how the change from the old to the new value is treated.
+	policy func(oldValue, newValue string, oldShoot, shoot *core.Shoot) transitionPolicy
+	// reason explains why the change is disruptive.
+	reason string
+}
+
+// transitions is the transition matrix of the network config.
+var transitions = []transition{
+	{
+		path:  []string{"ipam", "type"},
+		value: ipamType,
+		policy: func(oldValue, _ string, _, _ *core.Shoot) transitionPolicy {
+			if oldValue == apiscalico.IPAMCalico {
+				return transitionForbidden
+			}
+			// The admission does not know whether the extension migrates the IPAM node by node, as this depends on the
+			// SeamlessIPAMMigration feature gate of the extension, hence the change always requires the opt-in.
+			return transitionRequiresOptIn
+		},
+		reason: "pods keep their addresses from the previous IPAM until they are recreated, unless the SeamlessIPAMMigration feature gate of the extension is enabled and the shoot is IPv4 single-stack",
+	},
+	{
+		path:  []string{"ipam", "cidr"},
+		value: func(networkConfig *apiscalico.NetworkConfig, _ *core.Shoot) string { return ipamCIDR(networkConfig) },
+		policy: func(_, _ string, _, _ *core.Shoot) transitionPolicy {
+			return transitionForbidden
+		},
+		reason: "addresses which are already assigned to pods cannot be moved to another range",
+	},
+	{
+		path: []string{"backend"},
+		value: func(networkConfig *apiscalico.NetworkConfig, _ *core.Shoot) string {
+			return string(backend(networkConfig))
+		},
+		policy: func(_, _ string, _, _ *core.Shoot) transitionPolicy {
+			return transitionRequiresOptIn
+		},
+		reason: "routes between the nodes are reprogrammed, which interrupts pod to pod traffic",
+	},
+	{
+		path:  []string{"ipv4", "pool"},
+		value: ipv4Pool,
+		policy: func(oldValue, newValue string, _, _ *core.Shoot) transitionPolicy {
+			// The switch from IPIP to VXLAN can be migrated node by node by the extension.
+			if oldValue == string(apiscalico.PoolIPIP) && newValue == string(apiscalico.PoolVXLan) {
+				return transitionWarn
+			}
+			return transitionRequiresOptIn
+		},
+		reason: "the encapsulation of the pod traffic between nodes is switched, which interrupts pod to pod traffic unless the extension migrates it node by node",
+	},
+	{
+		path: []string{"ebpfDataplane", "enabled"},
+		value: func(networkConfig *apiscalico.NetworkConfig, _ *core.Shoot) string {
+			return strconv.FormatBool(networkConfig.EbpfDataplane != nil && networkConfig.EbpfDataplane.Enabled)
+		},
+		policy: func(_, _ string, oldShoot, shoot *core.Shoot) transitionPolicy {
+			if !kubeProxyEnabled(oldShoot) || !kubeProxyEnabled(shoot) {
+				return transitionForbidden
+			}
+			return transitionWarn
+		},
+		reason: "established connections are reset when the dataplane is switched, and services are unavailable if kube-proxy is disabled at the same time",
+	},
+}
+
+// ValidateNetworkConfigTransitions validates the changes of the network config of an updated shoot against the
+// transition matrix. Disruptive changes are either forbidden or require the shoot to be annotated with
+// calico.AnnotationAllowDisruptiveUpdate. Accepted changes which cause a disruption are returned as warnings.
+func ValidateNetworkConfigTransitions(oldNetworkConfig, networkConfig *apiscalico.NetworkConfig, oldShoot, shoot *core.Shoot, fldPath *field.Path) (field.ErrorList, []string) {
+	var (
+		allErrs  = field.ErrorList{}
+		warnings []string
+		optIn    = shoot.Annotations[calico.AnnotationAllowDisruptiveUpdate] == "true"
+	)
+
+	for _, t := range transitions {
+		oldValue, newValue := t.value(oldNetworkConfig, oldShoot), t.value(networkConfig, shoot)
+		if oldValue == newValue || oldValue == "" || newValue == "" {
+			continue
+		}
+
+		fld := fldPath.Child(t.path[0], t.path[1:]...)
+		switch t.policy(oldValue, newValue, oldShoot, shoot) {
+		case transitionWarn:
+			warnings = append(warnings, fmt.Sprintf("%s: changing the value from %q to %q is disruptive: %s", fld, oldValue, newValue, t.reason))
+		case transitionRequiresOptIn:
+			if !optIn {
+				allErrs = append(allErrs, field.Forbidden(fld, fmt.Sprintf("changing the value from %q to %q is disruptive (%s) and requires the shoot annotation %s=true", oldValue, newValue, t.reason, calico.AnnotationAllowDisruptiveUpdate)))
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("%s: changing the value from %q to %q is disruptive: %s", fld, oldValue, newValue, t.reason))
+		case transitionForbidden:
+			allErrs = append(allErrs, field.Forbidden(fld, fmt.Sprintf("changing the value from %q to %q is not supported: %s", oldValue, newValue, t.reason)))
+		}
+	}
+
+	return allErrs, warnings
+}
+
+// ipamType returns the effective IPAM type of the network config.
+func ipamType(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot) string {
+	if usesCalicoIPAM(networkConfig, shootIPFamilies(shoot)) {
+		return apiscalico.IPAMCalico
+	}
+	return apiscalico.IPAMHostLocal
+}
+
+// ipamCIDR returns the effective IPAM CIDR of the network config.
+func ipamCIDR(networkConfig *apiscalico.NetworkConfig) string {
+	if networkConfig.IPAM == nil || networkConfig.IPAM.CIDR == nil || strings.ToLower(string(*networkConfig.IPAM.CIDR)) == "usepodcidr" {
+		return "usePodCIDR"
+	}
+	return string(*networkConfig.IPAM.CIDR)
+}
+
+// backend returns the effective backend of the network config.
+func backend(networkConfig *apiscalico.NetworkConfig) apiscalico.Backend {
+	if networkConfig.Backend == nil {
+		return apiscalico.Bird
+	}
+	return *networkConfig.Backend
+}
+
+// ipv4Pool returns the effective encapsulation of the IPv4 pool. It is empty if the shoot does not use IPv4.
+func ipv4Pool(networkConfig *apiscalico.NetworkConfig, shoot *core.Shoot) string {
+	if shoot.Spec.Networking == nil || !usesIPv4(shoot.Spec.Networking.IPFamilies) {
+		return ""
+	}
+	if networkConfig.IPv4 != nil && networkConfig.IPv4.Pool != nil {
+		return string(*networkConfig.IPv4.Pool)
+	}
+	if networkConfig.VXLAN != nil && networkConfig.VXLAN.Enabled {
+		return string(apiscalico.PoolVXLan)
+	}
+	return string(apiscalico.PoolIPIP)
+}
+
+// kubeProxyEnabled returns true unless kube-proxy is explicitly disabled for the shoot.
+func kubeProxyEnabled(shoot *core.Shoot) bool {
+	kubeProxy := shoot.Spec.Kubernetes.KubeProxy
+	return kubeProxy == nil || kubeProxy.Enabled == nil || *kubeProxy.Enabled
+}
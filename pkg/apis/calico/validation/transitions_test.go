@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+var _ = Describe("Transition validation", func() {
+	DescribeTable("#ValidateNetworkConfigTransitions",
+		func(oldNetworkConfig, networkConfig *apiscalico.NetworkConfig, oldShoot, shoot *core.Shoot, errMatcher, warningsMatcher gomegatypes.GomegaMatcher) {
+			errList, warnings := validation.ValidateNetworkConfigTransitions(oldNetworkConfig, networkConfig, oldShoot, shoot, field.NewPath("config"))
+			Expect(errList).To(errMatcher)
+			Expect(warnings).To(warningsMatcher)
+		},
+
+		Entry("should succeed without changes",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{}, transitionShoot(nil), transitionShoot(nil),
+			BeEmpty(), BeEmpty()),
+		Entry("should succeed if only the implicit defaults are set explicitly",
+			&apiscalico.NetworkConfig{},
+			&apiscalico.NetworkConfig{
+				Backend: ptr.To(apiscalico.Bird),
+				IPAM:    &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal, CIDR: ptr.To[apiscalico.CIDR]("usePodCIDR")},
+				IPv4:    &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolIPIP)},
+			},
+			transitionShoot(nil), transitionShoot(nil),
+			BeEmpty(), BeEmpty()),
+		Entry("should forbid switching IPv4 single-stack shoots from host-local to calico-ipam without opt-in",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}},
+			transitionShoot(nil), transitionShoot(nil),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.type"), "Detail": ContainSubstring("SeamlessIPAMMigration")}))), BeEmpty()),
+		Entry("should allow switching IPv4 single-stack shoots from host-local to calico-ipam with opt-in",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}},
+			transitionShoot(nil), transitionShoot(map[string]string{calico.AnnotationAllowDisruptiveUpdate: "true"}),
+			BeEmpty(), ConsistOf(ContainSubstring("config.ipam.type"))),
+		Entry("should forbid switching dual-stack shoots from host-local to calico-ipam without opt-in",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}},
+			transitionShootWithIPFamilies(core.IPFamilyIPv4, core.IPFamilyIPv6), transitionShootWithIPFamilies(core.IPFamilyIPv4, core.IPFamilyIPv6),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.type"), "Detail": ContainSubstring(calico.AnnotationAllowDisruptiveUpdate)}))), BeEmpty()),
+		Entry("should allow switching dual-stack shoots from host-local to calico-ipam with opt-in",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}},
+			transitionShootWithIPFamilies(core.IPFamilyIPv4, core.IPFamilyIPv6), transitionShootWithAnnotationsAndIPFamilies(map[string]string{calico.AnnotationAllowDisruptiveUpdate: "true"}, core.IPFamilyIPv4, core.IPFamilyIPv6),
+			BeEmpty(), ConsistOf(ContainSubstring("config.ipam.type"))),
+		Entry("should forbid switching from calico-ipam to host-local even with opt-in",
+			&apiscalico.NetworkConfig{VXLAN: &apiscalico.VXLAN{Enabled: true}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolVXLan)}},
+			&apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolVXLan)}},
+			transitionShoot(nil), transitionShoot(map[string]string{calico.AnnotationAllowDisruptiveUpdate: "true"}),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.type")}))), BeEmpty()),
+		Entry("should forbid moving the IPAM CIDR",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal, CIDR: ptr.To[apiscalico.CIDR]("10.0.0.0/16")}},
+			transitionShoot(nil), transitionShoot(map[string]string{calico.AnnotationAllowDisruptiveUpdate: "true"}),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.cidr")}))), BeEmpty()),
+		Entry("should forbid changing the backend without opt-in",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{Backend: ptr.To(apiscalico.None)},
+			transitionShoot(nil), transitionShoot(nil),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.backend")}))), BeEmpty()),
+		Entry("should warn when switching the IPv4 pool from ipip to vxlan",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolVXLan)}},
+			transitionShoot(nil), transitionShoot(map[string]string{calico.AnnotationAllowDisruptiveUpdate: "false"}),
+			BeEmpty(), ConsistOf(ContainSubstring("config.ipv4.pool"))),
+		Entry("should forbid switching the IPv4 pool from vxlan to ipip without opt-in",
+			&apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolVXLan)}},
+			&apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMCalico}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolIPIP)}},
+			transitionShoot(nil), transitionShoot(nil),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipv4.pool")}))), BeEmpty()),
+		Entry("should consider enabling VXLAN as a switch to calico-ipam if the IPAM type is not set",
+			&apiscalico.NetworkConfig{},
+			&apiscalico.NetworkConfig{VXLAN: &apiscalico.VXLAN{Enabled: true}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolIPIP)}},
+			transitionShoot(nil), transitionShoot(nil),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ipam.type")}))), BeEmpty()),
+		Entry("should keep an explicitly configured host-local when enabling VXLAN",
+			&apiscalico.NetworkConfig{IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal}},
+			&apiscalico.NetworkConfig{VXLAN: &apiscalico.VXLAN{Enabled: true}, IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal}, IPv4: &apiscalico.IPv4{Pool: ptr.To(apiscalico.PoolIPIP)}},
+			transitionShoot(nil), transitionShoot(nil),
+			BeEmpty(), BeEmpty()),
+		Entry("should not consider the IPv4 pool of IPv6 single-stack shoots",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{VXLAN: &apiscalico.VXLAN{Enabled: true}, IPAM: &apiscalico.IPAM{Type: apiscalico.IPAMHostLocal}},
+			transitionShootWithIPFamilies(core.IPFamilyIPv6), transitionShootWithIPFamilies(core.IPFamilyIPv6),
+			BeEmpty(), BeEmpty()),
+		Entry("should warn when toggling the eBPF dataplane with kube-proxy enabled",
+			&apiscalico.NetworkConfig{}, &apiscalico.NetworkConfig{EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: true}},
+			transitionShoot(nil), transitionShoot(nil),
+			BeEmpty(), ConsistOf(ContainSubstring("config.ebpfDataplane.enabled"))),
+		Entry("should forbid toggling the eBPF dataplane while kube-proxy is disabled",
+			&apiscalico.NetworkConfig{EbpfDataplane: &apiscalico.EbpfDataplane{Enabled: true}}, &apiscalico.NetworkConfig{},
+			transitionShootWithKubeProxy(false), transitionShootWithKubeProxy(true),
+			ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("config.ebpfDataplane.enabled")}))), BeEmpty()),
+	)
+})
+
+func transitionShoot(annotations map[string]string) *core.Shoot {
+	shoot := shootWithNetworks(ptr.To("100.96.0.0/11"), nil, nil)
+	shoot.Annotations = annotations
+	return shoot
+}
+
+func transitionShootWithIPFamilies(ipFamilies ...core.IPFamily) *core.Shoot {
+	return transitionShootWithAnnotationsAndIPFamilies(nil, ipFamilies...)
+}
+
+func transitionShootWithAnnotationsAndIPFamilies(annotations map[string]string, ipFamilies ...core.IPFamily) *core.Shoot {
+	shoot := transitionShoot(annotations)
+	shoot.Spec.Networking.IPFamilies = ipFamilies
+	return shoot
+}
+
+func transitionShootWithKubeProxy(enabled bool) *core.Shoot {
+	shoot := transitionShoot(nil)
+	shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{Enabled: ptr.To(enabled)}
+	return shoot
+}
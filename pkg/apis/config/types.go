@@ -5,8 +5,11 @@
 package config
 
 import (
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config/v1alpha1"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -16,8 +19,43 @@ type ControllerConfiguration struct {
 	// ClientConnection specifies the kubeconfig file and client connection
 	// settings for the proxy server to use when communicating with the apiserver.
 	ClientConnection *componentbaseconfig.ClientConnectionConfiguration
+	// HealthCheckConfig is the config for the health check controller.
+	HealthCheckConfig *extensionsconfigv1alpha1.HealthCheckConfig
 	// FeatureGates is a map of feature names to bools that enable
 	// or disable alpha/experimental features.
 	// Default: nil
 	FeatureGates map[string]bool
+	// UnderlayMTUs maps provider types (e.g. aws) to the MTU of the node network of the provider. If the MTU of the
+	// provider of a shoot is known, the MTU of the pod interfaces is derived from it unless the shoot sets it explicitly.
+	UnderlayMTUs map[string]int32
+	// Defaults maps provider types (e.g. aws) to network configs whose settings are applied to the shoots of the
+	// provider unless their own network config sets them.
+	Defaults map[string]calicov1alpha1.NetworkConfig
+	// Constraints maps provider types (e.g. aws) to constraints the network configs of the shoots of the provider must
+	// satisfy.
+	Constraints map[string]NetworkConfigConstraints
+	// Profiles maps names to network config profiles which shoots can reference in their network config.
+	Profiles map[string]NetworkConfigProfile
+}
+
+// NetworkConfigProfile is a named network config which shoots can reference.
+type NetworkConfigProfile struct {
+	// Version is the version of the profile, which is reported in the status of the shoots using the profile. It
+	// should be changed whenever the profile changes.
+	Version string
+	// NetworkConfig is the network config of the profile. Its settings are applied to the shoots referencing the
+	// profile unless their own network config sets them.
+	NetworkConfig calicov1alpha1.NetworkConfig
+}
+
+// NetworkConfigConstraints restricts the network configs of shoots.
+type NetworkConfigConstraints struct {
+	// AllowedBackends is the list of backends shoots may configure. All backends are allowed if it is empty.
+	AllowedBackends []calicov1alpha1.Backend
+	// AllowedIPAMTypes is the list of IPAM types shoots may configure. All IPAM types are allowed if it is empty.
+	AllowedIPAMTypes []string
+	// EbpfDataplaneForbidden forbids shoots to enable the eBPF dataplane.
+	EbpfDataplaneForbidden bool
+	// WireguardEncryptionRequired requires shoots to enable WireGuard encryption.
+	WireguardEncryptionRequired bool
 }
@@ -5,8 +5,11 @@
 package v1alpha1
 
 import (
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 )
 
 // +genclient
@@ -18,9 +21,54 @@ type ControllerConfiguration struct {
 	// settings for the proxy server to use when communicating with the apiserver.
 	// +optional
 	ClientConnection *componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:"clientConnection,omitempty"`
+	// HealthCheckConfig is the config for the health check controller.
+	// +optional
+	HealthCheckConfig *extensionsconfigv1alpha1.HealthCheckConfig `json:"healthCheckConfig,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable
 	// or disable alpha/experimental features.
 	// Default: nil
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// UnderlayMTUs maps provider types (e.g. aws) to the MTU of the node network of the provider. If the MTU of the
+	// provider of a shoot is known, the MTU of the pod interfaces is derived from it unless the shoot sets it explicitly.
+	// +optional
+	UnderlayMTUs map[string]int32 `json:"underlayMTUs,omitempty"`
+	// Defaults maps provider types (e.g. aws) to network configs whose settings are applied to the shoots of the
+	// provider unless their own network config sets them.
+	// +optional
+	Defaults map[string]calicov1alpha1.NetworkConfig `json:"defaults,omitempty"`
+	// Constraints maps provider types (e.g. aws) to constraints the network configs of the shoots of the provider must
+	// satisfy.
+	// +optional
+	Constraints map[string]NetworkConfigConstraints `json:"constraints,omitempty"`
+	// Profiles maps names to network config profiles which shoots can reference in their network config.
+	// +optional
+	Profiles map[string]NetworkConfigProfile `json:"profiles,omitempty"`
+}
+
+// NetworkConfigProfile is a named network config which shoots can reference.
+type NetworkConfigProfile struct {
+	// Version is the version of the profile, which is reported in the status of the shoots using the profile. It
+	// should be changed whenever the profile changes.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// NetworkConfig is the network config of the profile. Its settings are applied to the shoots referencing the
+	// profile unless their own network config sets them.
+	NetworkConfig calicov1alpha1.NetworkConfig `json:"networkConfig"`
+}
+
+// NetworkConfigConstraints restricts the network configs of shoots.
+type NetworkConfigConstraints struct {
+	// AllowedBackends is the list of backends shoots may configure. All backends are allowed if it is empty.
+	// +optional
+	AllowedBackends []calicov1alpha1.Backend `json:"allowedBackends,omitempty"`
+	// AllowedIPAMTypes is the list of IPAM types shoots may configure. All IPAM types are allowed if it is empty.
+	// +optional
+	AllowedIPAMTypes []string `json:"allowedIPAMTypes,omitempty"`
+	// EbpfDataplaneForbidden forbids shoots to enable the eBPF dataplane.
+	// +optional
+	EbpfDataplaneForbidden bool `json:"ebpfDataplaneForbidden,omitempty"`
+	// WireguardEncryptionRequired requires shoots to enable WireGuard encryption.
+	// +optional
+	WireguardEncryptionRequired bool `json:"wireguardEncryptionRequired,omitempty"`
 }
@@ -12,7 +12,9 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 	config "github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -35,12 +37,37 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkConfigConstraints)(nil), (*config.NetworkConfigConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkConfigConstraints_To_config_NetworkConfigConstraints(a.(*NetworkConfigConstraints), b.(*config.NetworkConfigConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NetworkConfigConstraints)(nil), (*NetworkConfigConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NetworkConfigConstraints_To_v1alpha1_NetworkConfigConstraints(a.(*config.NetworkConfigConstraints), b.(*NetworkConfigConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkConfigProfile)(nil), (*config.NetworkConfigProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkConfigProfile_To_config_NetworkConfigProfile(a.(*NetworkConfigProfile), b.(*config.NetworkConfigProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NetworkConfigProfile)(nil), (*NetworkConfigProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NetworkConfigProfile_To_v1alpha1_NetworkConfigProfile(a.(*config.NetworkConfigProfile), b.(*NetworkConfigProfile), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.UnderlayMTUs = *(*map[string]int32)(unsafe.Pointer(&in.UnderlayMTUs))
+	out.Defaults = *(*map[string]calicov1alpha1.NetworkConfig)(unsafe.Pointer(&in.Defaults))
+	out.Constraints = *(*map[string]config.NetworkConfigConstraints)(unsafe.Pointer(&in.Constraints))
+	out.Profiles = *(*map[string]config.NetworkConfigProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -51,7 +78,12 @@ func Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(
 
 func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*configv1alpha1.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
+	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.UnderlayMTUs = *(*map[string]int32)(unsafe.Pointer(&in.UnderlayMTUs))
+	out.Defaults = *(*map[string]calicov1alpha1.NetworkConfig)(unsafe.Pointer(&in.Defaults))
+	out.Constraints = *(*map[string]NetworkConfigConstraints)(unsafe.Pointer(&in.Constraints))
+	out.Profiles = *(*map[string]NetworkConfigProfile)(unsafe.Pointer(&in.Profiles))
 	return nil
 }
 
@@ -59,3 +91,51 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in *config.ControllerConfiguration, out *ControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_NetworkConfigConstraints_To_config_NetworkConfigConstraints(in *NetworkConfigConstraints, out *config.NetworkConfigConstraints, s conversion.Scope) error {
+	out.AllowedBackends = *(*[]calicov1alpha1.Backend)(unsafe.Pointer(&in.AllowedBackends))
+	out.AllowedIPAMTypes = *(*[]string)(unsafe.Pointer(&in.AllowedIPAMTypes))
+	out.EbpfDataplaneForbidden = in.EbpfDataplaneForbidden
+	out.WireguardEncryptionRequired = in.WireguardEncryptionRequired
+	return nil
+}
+
+// Convert_v1alpha1_NetworkConfigConstraints_To_config_NetworkConfigConstraints is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkConfigConstraints_To_config_NetworkConfigConstraints(in *NetworkConfigConstraints, out *config.NetworkConfigConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkConfigConstraints_To_config_NetworkConfigConstraints(in, out, s)
+}
+
+func autoConvert_config_NetworkConfigConstraints_To_v1alpha1_NetworkConfigConstraints(in *config.NetworkConfigConstraints, out *NetworkConfigConstraints, s conversion.Scope) error {
+	out.AllowedBackends = *(*[]calicov1alpha1.Backend)(unsafe.Pointer(&in.AllowedBackends))
+	out.AllowedIPAMTypes = *(*[]string)(unsafe.Pointer(&in.AllowedIPAMTypes))
+	out.EbpfDataplaneForbidden = in.EbpfDataplaneForbidden
+	out.WireguardEncryptionRequired = in.WireguardEncryptionRequired
+	return nil
+}
+
+// Convert_config_NetworkConfigConstraints_To_v1alpha1_NetworkConfigConstraints is an autogenerated conversion function.
+func Convert_config_NetworkConfigConstraints_To_v1alpha1_NetworkConfigConstraints(in *config.NetworkConfigConstraints, out *NetworkConfigConstraints, s conversion.Scope) error {
+	return autoConvert_config_NetworkConfigConstraints_To_v1alpha1_NetworkConfigConstraints(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkConfigProfile_To_config_NetworkConfigProfile(in *NetworkConfigProfile, out *config.NetworkConfigProfile, s conversion.Scope) error {
+	out.Version = in.Version
+	out.NetworkConfig = in.NetworkConfig
+	return nil
+}
+
+// Convert_v1alpha1_NetworkConfigProfile_To_config_NetworkConfigProfile is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkConfigProfile_To_config_NetworkConfigProfile(in *NetworkConfigProfile, out *config.NetworkConfigProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkConfigProfile_To_config_NetworkConfigProfile(in, out, s)
+}
+
+func autoConvert_config_NetworkConfigProfile_To_v1alpha1_NetworkConfigProfile(in *config.NetworkConfigProfile, out *NetworkConfigProfile, s conversion.Scope) error {
+	out.Version = in.Version
+	out.NetworkConfig = in.NetworkConfig
+	return nil
+}
+
+// Convert_config_NetworkConfigProfile_To_v1alpha1_NetworkConfigProfile is an autogenerated conversion function.
+func Convert_config_NetworkConfigProfile_To_v1alpha1_NetworkConfigProfile(in *config.NetworkConfigProfile, out *NetworkConfigProfile, s conversion.Scope) error {
+	return autoConvert_config_NetworkConfigProfile_To_v1alpha1_NetworkConfigProfile(in, out, s)
+}
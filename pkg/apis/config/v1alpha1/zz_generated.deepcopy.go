@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -23,6 +24,10 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(configv1alpha1.ClientConnectionConfiguration)
 		**out = **in
 	}
+	if in.HealthCheckConfig != nil {
+		in, out := &in.HealthCheckConfig, &out.HealthCheckConfig
+		*out = (*in).DeepCopy()
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -30,6 +35,34 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 			(*out)[key] = val
 		}
 	}
+	if in.UnderlayMTUs != nil {
+		in, out := &in.UnderlayMTUs, &out.UnderlayMTUs
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = make(map[string]calicov1alpha1.NetworkConfig, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = make(map[string]NetworkConfigConstraints, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make(map[string]NetworkConfigProfile, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -50,3 +83,46 @@ func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfigConstraints) DeepCopyInto(out *NetworkConfigConstraints) {
+	*out = *in
+	if in.AllowedBackends != nil {
+		in, out := &in.AllowedBackends, &out.AllowedBackends
+		*out = make([]calicov1alpha1.Backend, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIPAMTypes != nil {
+		in, out := &in.AllowedIPAMTypes, &out.AllowedIPAMTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfigConstraints.
+func (in *NetworkConfigConstraints) DeepCopy() *NetworkConfigConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfigConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfigProfile) DeepCopyInto(out *NetworkConfigProfile) {
+	*out = *in
+	in.NetworkConfig.DeepCopyInto(&out.NetworkConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfigProfile.
+func (in *NetworkConfigProfile) DeepCopy() *NetworkConfigProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfigProfile)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -24,11 +24,30 @@ const (
 	MultusImageName                                = "multus-cni"
 	CNIPluginsImageName                            = "cni-plugins"
 
+	// CalicoNodeDaemonSetName is the name of the calico-node DaemonSet in the shoot cluster.
+	CalicoNodeDaemonSetName = "calico-node"
+	// CalicoNodeWorkerPoolAppLabel is the k8s-app label of the pods of the calico-node DaemonSets of worker pools with
+	// individual settings, which must not match the selector of the calico-node DaemonSet.
+	CalicoNodeWorkerPoolAppLabel = "calico-node-worker-pool"
+	// CalicoTyphaDeploymentName is the name of the calico-typha Deployment in the shoot cluster.
+	CalicoTyphaDeploymentName = "calico-typha-deploy"
+	// CalicoKubeControllersDeploymentName is the name of the calico-kube-controllers Deployment in the shoot cluster.
+	CalicoKubeControllersDeploymentName = "calico-kube-controllers"
+	// MultusDaemonSetName is the name of the Multus DaemonSet in the shoot cluster.
+	MultusDaemonSetName = "multus"
+
 	// MonitoringChartName
 	MonitoringName = "calico-monitoring-config"
 
 	// ReleaseName is the name of the Calico Release
 	ReleaseName = "calico"
+
+	// AnnotationAllowDisruptiveUpdate is the shoot annotation to opt in to disruptive changes of the networking
+	// provider config, which are rejected by the admission otherwise.
+	AnnotationAllowDisruptiveUpdate = "calico.networking.extensions.gardener.cloud/allow-disruptive-update"
+	// AnnotationWireguardKeyRotation is the shoot annotation to trigger a rotation of the WireGuard keys of the nodes.
+	// A new rotation is triggered whenever its value changes.
+	AnnotationWireguardKeyRotation = "calico.networking.extensions.gardener.cloud/wireguard-key-rotation"
 )
 
 var (
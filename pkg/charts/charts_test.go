@@ -7,6 +7,7 @@ package charts
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -18,10 +19,13 @@ import (
 	"go.uber.org/mock/gomock"
 	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
 
 	"github.com/gardener/gardener-extension-networking-calico/charts"
 	"github.com/gardener/gardener-extension-networking-calico/imagevector"
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
 
@@ -256,7 +260,7 @@ var _ = Describe("Chart package test", func() {
 		func(config func() *calicov1alpha1.NetworkConfig, configResult func() *calicov1alpha1.NetworkConfig, typhaEnabled bool, wantsVPA bool,
 			kubeProxyEnabled bool, mtu string, ipinip bool, bpf bool, kubeProxyMode *corev1beta1.ProxyMode, pool string, birdExporterEnabled bool, multusEnabled bool, installCNIPlugins bool,
 			modeFunc func() string, detectionMethodFunc func() *string, nodesFunc func() *string, additionalGlobalOptions map[string]string) {
-			values, err := ComputeCalicoChartValues(network, config(), kubernetesVersion, wantsVPA, kubeProxyEnabled, kubeProxyMode, false, nodesFunc(), []string{network.Spec.PodCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})
+			values, err := ComputeCalicoChartValues(network, config(), kubernetesVersion, wantsVPA, kubeProxyEnabled, kubeProxyMode, false, nodesFunc(), []string{network.Spec.PodCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
 			Expect(err).To(BeNil())
 
 			expected := map[string]interface{}{
@@ -304,6 +308,10 @@ var _ = Describe("Chart package test", func() {
 						"ipinip": map[string]interface{}{
 							"enabled": ipinip,
 						},
+						"vxlan": map[string]interface{}{
+							"enabled":   false,
+							"enabledV6": false,
+						},
 						"bpf": map[string]interface{}{
 							"enabled": bpf,
 						},
@@ -318,6 +326,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                pool,
 						"mode":                modeFunc(),
+						"encapsulationMode":   modeFunc(),
 						"autoDetectionMethod": nil,
 						"wireguard":           configResult().WireguardEncryption,
 					},
@@ -325,6 +334,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             false,
 						"pool":                "",
 						"mode":                "",
+						"vxlanMode":           "",
 						"autoDetectionMethod": nil,
 						"natOutgoing":         configResult().WireguardEncryption,
 						"wireguard":           configResult().WireguardEncryption,
@@ -445,7 +455,7 @@ var _ = Describe("Chart package test", func() {
 		var podCIDR = "12.0.0.0/8"
 		DescribeTable("should correctly compute calico chart values with non-privileged mode enabled",
 			func(config func() *calicov1alpha1.NetworkConfig, expectedResult bool) {
-				values, err := ComputeCalicoChartValues(network, config(), kubernetesVersion, true, true, nil, true, &nodeCIDR, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})
+				values, err := ComputeCalicoChartValues(network, config(), kubernetesVersion, true, true, nil, true, &nodeCIDR, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
 				Expect(err).To(BeNil())
 
 				actual, err := utils.GetFromValuesMap(values, "config", "nonPrivileged")
@@ -458,7 +468,7 @@ var _ = Describe("Chart package test", func() {
 		)
 
 		It("should error on invalid config value", func() {
-			_, err := ComputeCalicoChartValues(network, networkConfigInvalid, kubernetesVersion, true, true, nil, false, &nodeCIDR, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})
+			_, err := ComputeCalicoChartValues(network, networkConfigInvalid, kubernetesVersion, true, true, nil, false, &nodeCIDR, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
 			Expect(err).To(Equal(fmt.Errorf("error when generating calico config: unsupported value for backend: invalid")))
 		})
 
@@ -476,7 +486,7 @@ var _ = Describe("Chart package test", func() {
 			It("should correctly configure for IPv4 networks", func() {
 				values, err := ComputeCalicoChartValues(
 					network,
-					nil, "", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4},
+					nil, "", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -492,6 +502,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "ipip",
 						"mode":                "Always",
+						"encapsulationMode":   "Always",
 						"autoDetectionMethod": nil,
 						"wireguard":           false,
 					})),
@@ -513,7 +524,7 @@ var _ = Describe("Chart package test", func() {
 				}
 				values, err := ComputeCalicoChartValues(
 					network, config,
-					"", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4},
+					"", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -529,6 +540,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "vxlan",
 						"mode":                "CrossSubnet",
+						"encapsulationMode":   "CrossSubnet",
 						"autoDetectionMethod": "first-found",
 						"wireguard":           false,
 					})),
@@ -546,7 +558,7 @@ var _ = Describe("Chart package test", func() {
 				}
 				values, err := ComputeCalicoChartValues(
 					network, config,
-					"", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4},
+					"", false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -562,6 +574,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "ipip",
 						"mode":                "Off",
+						"encapsulationMode":   "Never",
 						"autoDetectionMethod": nil,
 						"wireguard":           false,
 					})),
@@ -587,7 +600,7 @@ var _ = Describe("Chart package test", func() {
 			It("should correctly configure for IPv6 networks", func() {
 				values, err := ComputeCalicoChartValues(
 					network,
-					nil, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6},
+					nil, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -606,6 +619,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "vxlan",
 						"mode":                "Never",
+						"vxlanMode":           "Never",
 						"autoDetectionMethod": nil,
 						"natOutgoing":         false,
 						"wireguard":           false,
@@ -625,7 +639,7 @@ var _ = Describe("Chart package test", func() {
 				}
 				values, err := ComputeCalicoChartValues(
 					network, config,
-					"", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6},
+					"", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -644,6 +658,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "vxlan",
 						"mode":                "CrossSubnet",
+						"vxlanMode":           "CrossSubnet",
 						"autoDetectionMethod": "first-found",
 						"natOutgoing":         false,
 						"wireguard":           false,
@@ -653,6 +668,44 @@ var _ = Describe("Chart package test", func() {
 					HaveKeyWithValue("podCIDRv6", "2001:0db8:85a3:0000::/56"),
 				)
 			})
+			It("should not encapsulate the IPv6 pod traffic if only the overlay is enabled", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					Overlay: &calicov1alpha1.Overlay{Enabled: true},
+					IPv6:    &calicov1alpha1.IPv6{},
+				}
+				values, err := ComputeCalicoChartValues(
+					network, config,
+					"", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil, "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipv6", And(
+					HaveKeyWithValue("mode", "Always"),
+					HaveKeyWithValue("vxlanMode", "Never"),
+				)))
+			})
+			It("should use calico-ipam with the configured block size", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico},
+					IPv6: &calicov1alpha1.IPv6{BlockSize: pointer[int32](120)},
+				}
+				values, err := ComputeCalicoChartValues(
+					network, config,
+					"", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil, "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("ipam", Equal(map[string]interface{}{
+						"type":        "calico-ipam",
+						"subnet":      "",
+						"assign_ipv4": false,
+						"assign_ipv6": true,
+						"ranges":      nil,
+					})),
+					HaveKeyWithValue("ipv6", HaveKeyWithValue("blockSize", float64(120))),
+				))
+			})
 		})
 		Context("Dual-stack", func() {
 			BeforeEach(func() {
@@ -669,7 +722,7 @@ var _ = Describe("Chart package test", func() {
 			It("should correctly configure for Dual-stack networks", func() {
 				values, err := ComputeCalicoChartValues(
 					network,
-					nil, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56", podCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6},
+					nil, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56", podCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}, nil, "",
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -688,6 +741,7 @@ var _ = Describe("Chart package test", func() {
 						"enabled":             true,
 						"pool":                "vxlan",
 						"mode":                "Never",
+						"vxlanMode":           "Never",
 						"autoDetectionMethod": nil,
 						"natOutgoing":         false,
 						"wireguard":           false,
@@ -698,6 +752,57 @@ var _ = Describe("Chart package test", func() {
 					HaveKeyWithValue("podCIDRv6", "2001:0db8:85a3:0000::/56"),
 				))
 			})
+			It("should use calico-ipam for both IP families", func() {
+				config := &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+				values, err := ComputeCalicoChartValues(
+					network, config,
+					"", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56", podCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}, nil, "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", Equal(map[string]interface{}{
+					"type":        "calico-ipam",
+					"subnet":      "",
+					"assign_ipv4": true,
+					"assign_ipv6": true,
+					"ranges":      nil,
+				})))
+			})
+			It("should only assign IPv4 addresses to pods while preparing the dual-stack migration", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPv4ToDualStack, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(
+					network,
+					nil, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56", podCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}, migration, "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("ipam", And(
+						HaveKeyWithValue("subnet", "usePodCidr"),
+						HaveKeyWithValue("assign_ipv4", true),
+						HaveKeyWithValue("assign_ipv6", false),
+						HaveKeyWithValue("ranges", BeNil()),
+					)),
+					HaveKeyWithValue("ipv6", HaveKeyWithValue("enabled", true)),
+				))
+			})
+			It("should not use the pod CIDR of the node with calico-ipam while preparing the dual-stack migration", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPv4ToDualStack, Phase: calicov1alpha1.MigrationPhasePreparing}
+				config := &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+				values, err := ComputeCalicoChartValues(
+					network,
+					config, "", false, false, nil, false, nil, []string{"2001:0db8:85a3:0000::/56", podCIDR}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}, migration, "",
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", Equal(map[string]interface{}{
+					"type":        "calico-ipam",
+					"subnet":      "",
+					"assign_ipv4": true,
+					"assign_ipv6": false,
+					"ranges":      nil,
+				})))
+			})
 		})
 		Context("nftables", func() {
 			BeforeEach(func() {
@@ -706,7 +811,7 @@ var _ = Describe("Chart package test", func() {
 			It("should not enable nftables if kube-proxy is in iptables mode", func() {
 				enablekubeproxy := true
 				kubeproxymode := corev1beta1.ProxyModeIPTables
-				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil)
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil, nil, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(values["config"]).To(
@@ -721,7 +826,7 @@ var _ = Describe("Chart package test", func() {
 			It("should not enable nftables if kube-proxy is in ipvs mode", func() {
 				enablekubeproxy := true
 				kubeproxymode := corev1beta1.ProxyModeIPVS
-				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil)
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil, nil, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(values["config"]).To(
@@ -736,7 +841,7 @@ var _ = Describe("Chart package test", func() {
 			It("should enable nftables if kube-proxy is in nftables mode", func() {
 				enablekubeproxy := true
 				kubeproxymode := corev1beta1.ProxyModeNFTables
-				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil)
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil, nil, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(values["config"]).To(
@@ -751,12 +856,642 @@ var _ = Describe("Chart package test", func() {
 			It("should error out if kubeProxyMode is set but kube-proxy is not enabled", func() {
 				enablekubeproxy := false
 				kubeproxymode := corev1beta1.ProxyModeNFTables
-				_, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil)
+				_, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, enablekubeproxy, &kubeproxymode, false, nil, nil, nil, nil, "")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("BGP", func() {
+			BeforeEach(func() {
+				network = &extensionsv1alpha1.Network{
+					Spec: extensionsv1alpha1.NetworkSpec{
+						IPFamilies: []extensionsv1alpha1.IPFamily{
+							extensionsv1alpha1.IPFamilyIPv4,
+						},
+						PodCIDR: podCIDR,
+					},
+				}
+			})
+			It("should not configure BGP per default", func() {
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).NotTo(HaveKey("bgp"))
+			})
+			It("should configure BGP peers from the config", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					BGP: &calicov1alpha1.BGP{
+						ASNumber: pointer[uint32](64512),
+						Peers: []calicov1alpha1.BGPPeer{
+							{Name: "tor-router", PeerIP: pointer("10.250.0.1"), ASNumber: pointer[uint32](64513), NodeSelector: pointer("rack == 'rack-1'")},
+							{Name: "in-cluster", PeerSelector: pointer("has(bgp-peer)")},
+						},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("bgp", Equal(map[string]interface{}{
+					"asNumber":              float64(64512),
+					"nodeToNodeMeshEnabled": true,
+					"peers": []interface{}{
+						map[string]interface{}{
+							"name":         "tor-router",
+							"peerIP":       "10.250.0.1",
+							"asNumber":     float64(64513),
+							"nodeSelector": "rack == 'rack-1'",
+						},
+						map[string]interface{}{
+							"name":         "in-cluster",
+							"peerSelector": "has(bgp-peer)",
+						},
+					},
+				})))
+			})
+			It("should disable the node-to-node mesh per default if route reflectors are configured", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					BGP: &calicov1alpha1.BGP{
+						RouteReflector: &calicov1alpha1.BGPRouteReflector{NodeSelector: "route-reflector == 'true'"},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("bgp", Equal(map[string]interface{}{
+					"nodeToNodeMeshEnabled":  false,
+					"routeReflectorSelector": "route-reflector == 'true'",
+				})))
+			})
+			It("should configure the advertisement of service IPs", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					BGP: &calicov1alpha1.BGP{
+						ServiceClusterIPs:      &calicov1alpha1.BGPServiceClusterIPs{CIDRs: []calicov1alpha1.CIDR{"10.96.0.0/24"}},
+						ServiceExternalIPs:     []calicov1alpha1.CIDR{"192.0.2.0/24"},
+						ServiceLoadBalancerIPs: []calicov1alpha1.CIDR{"198.51.100.0/24"},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("bgp", Equal(map[string]interface{}{
+					"nodeToNodeMeshEnabled":  true,
+					"serviceClusterIPs":      []interface{}{"10.96.0.0/24"},
+					"serviceExternalIPs":     []interface{}{"192.0.2.0/24"},
+					"serviceLoadBalancerIPs": []interface{}{"198.51.100.0/24"},
+				})))
+			})
+			It("should error out if the backend is not bird", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					Backend: &backendVXLan,
+					BGP:     &calicov1alpha1.BGP{},
+				}
+				_, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("IPAM config", func() {
+			BeforeEach(func() {
+				network = &extensionsv1alpha1.Network{
+					Spec: extensionsv1alpha1.NetworkSpec{
+						IPFamilies: []extensionsv1alpha1.IPFamily{
+							extensionsv1alpha1.IPFamilyIPv4,
+						},
+						PodCIDR: podCIDR,
+					},
+				}
+			})
+			It("should not configure the IPAM per default", func() {
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", Not(HaveKey("config"))))
+			})
+			It("should configure the IPAM with defaults for unset fields", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPAM: &calicov1alpha1.IPAM{
+						Type:   calicov1alpha1.IPAMCalico,
+						Config: &calicov1alpha1.IPAMConfig{StrictAffinity: pointer(true)},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", HaveKeyWithValue("config", Equal(map[string]interface{}{
+					"strictAffinity":     true,
+					"maxBlocksPerHost":   float64(0),
+					"autoAllocateBlocks": true,
+				}))))
+			})
+			It("should keep host-local while preparing the migration to calico-ipam", func() {
+				config := &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", HaveKeyWithValue("type", calicov1alpha1.IPAMHostLocal)))
+			})
+			It("should switch to calico-ipam while migrating", func() {
+				config := &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhaseMigrating}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", HaveKeyWithValue("type", calicov1alpha1.IPAMCalico)))
+			})
+		})
+
+		Context("IP pools", func() {
+			BeforeEach(func() {
+				network = &extensionsv1alpha1.Network{
+					Spec: extensionsv1alpha1.NetworkSpec{
+						IPFamilies: []extensionsv1alpha1.IPFamily{
+							extensionsv1alpha1.IPFamilyIPv4,
+						},
+						PodCIDR: podCIDR,
+					},
+				}
+			})
+			It("should not configure additional IP pools per default", func() {
+				values, err := ComputeCalicoChartValues(network, nil, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).NotTo(HaveKey("ipPools"))
+			})
+			It("should configure additional IP pools with the defaults of the default IP pool", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPPools: []calicov1alpha1.IPPool{
+						{Name: "tenant-a", CIDR: "172.16.0.0/16", NamespaceSelector: pointer("tenant == 'a'")},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipPools", Equal([]interface{}{
+					map[string]interface{}{
+						"name":              "tenant-a",
+						"cidr":              "172.16.0.0/16",
+						"blockSize":         float64(26),
+						"ipipMode":          "Always",
+						"vxlanMode":         "Never",
+						"natOutgoing":       true,
+						"nodeSelector":      "all()",
+						"namespaceSelector": "tenant == 'a'",
+						"allowedUses":       []interface{}{"Workload", "Tunnel"},
+					},
+				})))
+			})
+			It("should configure additional IP pools from the config", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPPools: []calicov1alpha1.IPPool{
+						{
+							Name:          "load-balancers",
+							CIDR:          "172.17.0.0/24",
+							BlockSize:     pointer[int32](28),
+							Encapsulation: &poolIPIP,
+							Mode:          &crossSubnet,
+							NATOutgoing:   pointer(false),
+							NodeSelector:  pointer("worker.gardener.cloud/pool == 'a'"),
+							AllowedUses:   []calicov1alpha1.IPPoolAllowedUse{calicov1alpha1.IPPoolAllowedUseLoadBalancer},
+						},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipPools", Equal([]interface{}{
+					map[string]interface{}{
+						"name":         "load-balancers",
+						"cidr":         "172.17.0.0/24",
+						"blockSize":    float64(28),
+						"ipipMode":     "CrossSubnet",
+						"vxlanMode":    "Never",
+						"natOutgoing":  false,
+						"nodeSelector": "worker.gardener.cloud/pool == 'a'",
+						"allowedUses":  []interface{}{"LoadBalancer"},
+					},
+				})))
+			})
+			It("should not use an encapsulation if the overlay is disabled", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					Overlay: &calicov1alpha1.Overlay{Enabled: false},
+					IPPools: []calicov1alpha1.IPPool{{Name: "tenant-a", CIDR: "172.16.0.0/16"}},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipPools", ConsistOf(And(
+					HaveKeyWithValue("ipipMode", "Never"),
+					HaveKeyWithValue("vxlanMode", "Never"),
+				))))
+			})
+			It("should use the configured block size of the default IP pool", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPv4:    &calicov1alpha1.IPv4{BlockSize: pointer[int32](24)},
+					IPPools: []calicov1alpha1.IPPool{{Name: "tenant-a", CIDR: "172.16.0.0/16"}},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipv4", HaveKeyWithValue("blockSize", float64(24))))
+				Expect(values["config"]).To(HaveKeyWithValue("ipPools", ConsistOf(HaveKeyWithValue("blockSize", float64(24)))))
+			})
+			It("should error out if the IP family of the pool is not used by the shoot", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPPools: []calicov1alpha1.IPPool{{Name: "ipv6", CIDR: "2001:db8::/64"}},
+				}
+				_, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		Context("VXLAN", func() {
+			var config *calicov1alpha1.NetworkConfig
+
+			BeforeEach(func() {
+				config = &calicov1alpha1.NetworkConfig{
+					Backend: &backendVXLan,
+					Overlay: &calicov1alpha1.Overlay{Enabled: true},
+					VXLAN:   &calicov1alpha1.VXLAN{Enabled: true},
+					IPv4:    &calicov1alpha1.IPv4{},
+				}
+			})
+
+			It("should use calico-ipam per default", func() {
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["global"]).To(HaveKeyWithValue("vxlanEnabled", "true"))
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", HaveKeyWithValue("type", calicov1alpha1.IPAMCalico)))
+				Expect(values["config"]).To(HaveKeyWithValue("ipv4", HaveKeyWithValue("pool", string(calicov1alpha1.PoolVXLan))))
+			})
+			It("should keep an explicitly configured IPAM type", func() {
+				config.IPAM = &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMHostLocal}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", HaveKeyWithValue("type", calicov1alpha1.IPAMHostLocal)))
+			})
+			It("should keep host-local for IPv6 single-stack shoots", func() {
+				config = &calicov1alpha1.NetworkConfig{
+					Overlay: &calicov1alpha1.Overlay{Enabled: true},
+					VXLAN:   &calicov1alpha1.VXLAN{Enabled: true},
+				}
+				calicov1alpha1.SetObjectDefaults_NetworkConfig(config)
+				network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", And(
+					HaveKeyWithValue("type", calicov1alpha1.IPAMHostLocal),
+					HaveKeyWithValue("subnet", "usePodCidrIPv6"),
+				)))
+			})
+			It("should keep the IPIP encapsulation while preparing the migration to VXLAN", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPIPToVXLAN, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["global"]).NotTo(HaveKey("vxlanEnabled"))
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("backend", string(calicov1alpha1.Bird)),
+					HaveKeyWithValue("ipv4", HaveKeyWithValue("pool", string(calicov1alpha1.PoolIPIP))),
+					HaveKeyWithValue("felix", And(
+						HaveKeyWithValue("ipinip", HaveKeyWithValue("enabled", true)),
+						HaveKeyWithValue("vxlan", HaveKeyWithValue("enabled", true)),
+					)),
+				))
+			})
+			It("should disable the IPv4 pool encapsulation while preparing the overlay enablement", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeOverlayEnablement, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipv4", HaveKeyWithValue("mode", string(calicov1alpha1.Never))))
+			})
+			It("should disable the IPv6 pool encapsulation but enable the IPv6 vxlan tunnels while preparing the overlay enablement", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					Overlay: &calicov1alpha1.Overlay{Enabled: true},
+					IPv6:    &calicov1alpha1.IPv6{Mode: pointer(calicov1alpha1.Always)},
+				}
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeOverlayEnablement, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, []string{"2001:0db8:85a3:0000::/56"}, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("ipv6", HaveKeyWithValue("vxlanMode", string(calicov1alpha1.Never))),
+					HaveKeyWithValue("felix", HaveKeyWithValue("vxlan", HaveKeyWithValue("enabledV6", true))),
+				))
+			})
+		})
+
+		Context("IPAM migration", func() {
+			var config *calicov1alpha1.NetworkConfig
+
+			BeforeEach(func() {
+				config = &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+			})
+
+			It("should keep host-local while preparing the migration", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", And(
+					HaveKeyWithValue("type", calicov1alpha1.IPAMHostLocal),
+					Not(HaveKey("upgrade")),
+				)))
+			})
+			It("should upgrade the host-local allocations while migrating", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhaseMigrating}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", And(
+					HaveKeyWithValue("type", calicov1alpha1.IPAMCalico),
+					HaveKeyWithValue("upgrade", true),
+				)))
+			})
+			It("should not upgrade the host-local allocations without a migration", func() {
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("ipam", And(
+					HaveKeyWithValue("type", calicov1alpha1.IPAMCalico),
+					Not(HaveKey("upgrade")),
+				)))
+			})
+		})
+		Context("eBPF dataplane migration", func() {
+			It("should keep the rules of kube-proxy while migrating to eBPF", func() {
+				config := &calicov1alpha1.NetworkConfig{EbpfDataplane: &calicov1alpha1.EbpfDataplane{Enabled: true}}
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPTablesToEBPF, Phase: calicov1alpha1.MigrationPhaseMigrating}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, false, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("felix", And(
+					HaveKeyWithValue("bpf", HaveKeyWithValue("enabled", true)),
+					HaveKeyWithValue("bpfKubeProxyIPTablesCleanup", HaveKeyWithValue("enabled", false)),
+				)))
+			})
+			It("should keep the eBPF dataplane while preparing the migration to iptables", func() {
+				migration := &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeEBPFToIPTables, Phase: calicov1alpha1.MigrationPhasePreparing}
+				values, err := ComputeCalicoChartValues(network, &calicov1alpha1.NetworkConfig{}, kubernetesVersion, false, true, nil, true, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("felix", HaveKeyWithValue("bpf", HaveKeyWithValue("enabled", true))),
+					HaveKeyWithValue("nonPrivileged", false),
+				))
+			})
+		})
+		Context("Deprecated fields", func() {
+			ipFamilies := []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+
+			BeforeEach(func() {
+				network = &extensionsv1alpha1.Network{Spec: extensionsv1alpha1.NetworkSpec{IPFamilies: ipFamilies}}
+			})
+
+			DescribeTable("should render the same values after migrating the deprecated fields",
+				func(config *calicov1alpha1.NetworkConfig, migrated bool) {
+					expected, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, ipFamilies, nil, "")
+					Expect(err).NotTo(HaveOccurred())
+
+					config = config.DeepCopy()
+					Expect(calicov1alpha1helper.MigrateDeprecatedFields(config, true)).To(Equal(migrated))
+					values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, ipFamilies, nil, "")
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(values).To(Equal(expected))
+				},
+
+				Entry("ipip mode", &calicov1alpha1.NetworkConfig{IPIP: &crossSubnet}, true),
+				Entry("autodetection method", &calicov1alpha1.NetworkConfig{IPAutoDetectionMethod: &autodetectionMethod}, true),
+				Entry("ipip mode with IPv4 section", &calicov1alpha1.NetworkConfig{IPIP: &never, IPv4: &calicov1alpha1.IPv4{Mode: &always}}, true),
+				Entry("ipip mode with VXLAN", &calicov1alpha1.NetworkConfig{
+					IPIP:  &always,
+					IPAM:  &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMHostLocal},
+					VXLAN: &calicov1alpha1.VXLAN{Enabled: true},
+				}, false),
+				Entry("ipip mode with VXLAN and IPv4 section", &calicov1alpha1.NetworkConfig{
+					IPIP:  &always,
+					IPv4:  &calicov1alpha1.IPv4{},
+					VXLAN: &calicov1alpha1.VXLAN{Enabled: true},
+				}, true),
+			)
+		})
+		Context("Felix configuration", func() {
+			It("should pass the felix settings as environment variables", func() {
+				config := &calicov1alpha1.NetworkConfig{Felix: &calicov1alpha1.FelixConfiguration{
+					LogSeverityScreen:    pointer("Warning"),
+					RouteRefreshInterval: &metav1.Duration{Duration: 90 * time.Second},
+					BPFConntrackTimeouts: &calicov1alpha1.FelixConntrackTimeouts{
+						TCPSynSent: &metav1.Duration{Duration: 20 * time.Second},
+						UDPTimeout: &metav1.Duration{Duration: time.Minute},
+					},
+					FailsafeInboundHostPorts: []calicov1alpha1.FelixProtoPort{
+						{Port: 22},
+						{Protocol: pointer("udp"), Net: pointer("fd00::/8"), Port: 53},
+					},
+					NATPortRange: pointer("20000:30000"),
+				}}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("felix", HaveKeyWithValue("env", Equal(map[string]interface{}{
+					"FELIX_LOGSEVERITYSCREEN":        "Warning",
+					"FELIX_ROUTEREFRESHINTERVAL":     "90",
+					"FELIX_BPFCONNTRACKTIMEOUTS":     "TCPSynSent=20s,UDPTimeout=1m0s",
+					"FELIX_FAILSAFEINBOUNDHOSTPORTS": "tcp:22,udp:[fd00::/8]:53",
+					"FELIX_NATPORTRANGE":             "20000:30000",
+				}))))
+			})
+			It("should not pass felix settings if none are configured", func() {
+				values, err := ComputeCalicoChartValues(network, &calicov1alpha1.NetworkConfig{}, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(HaveKeyWithValue("felix", Not(HaveKey("env"))))
+			})
+		})
+
+		Context("WireGuard", func() {
+			It("should set the WireGuard settings and the key rotation", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					WireguardEncryption: true,
+					Wireguard: &calicov1alpha1.Wireguard{
+						HostEncryptionEnabled: pointer(true),
+						RoutingRulePriority:   pointer[int32](100),
+						InterfaceName:         pointer("wg0"),
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "2025-01")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("wireguard", Equal(map[string]interface{}{
+						"hostEncryptionEnabled": true,
+						"routingRulePriority":   float64(100),
+						"interfaceName":         "wg0",
+					})),
+					HaveKeyWithValue("wireguardKeyRotation", "2025-01"),
+				))
+			})
+
+			It("should not set the key rotation if WireGuard encryption is disabled", func() {
+				values, err := ComputeCalicoChartValues(network, &calicov1alpha1.NetworkConfig{}, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "2025-01")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).NotTo(Or(HaveKey("wireguard"), HaveKey("wireguardKeyRotation")))
+			})
+		})
+
+		Context("Worker pools", func() {
+			It("should compute the calico-node values of the worker pools with individual settings", func() {
+				config := &calicov1alpha1.NetworkConfig{
+					IPv4: &calicov1alpha1.IPv4{AutoDetectionMethod: pointer("first-found")},
+					WorkerPools: []calicov1alpha1.WorkerPool{
+						{Name: "ebpf", EbpfDataplane: &calicov1alpha1.EbpfDataplane{Enabled: true}},
+						{Name: "jumbo", IPv4AutoDetectionMethod: pointer("interface=eth1"), VethMTU: pointer("8950")},
+					},
+				}
+				values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, false, true, nil, true, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values["config"]).To(And(
+					HaveKeyWithValue("felix", HaveKeyWithValue("bpf", HaveKeyWithValue("enabled", false))),
+					HaveKeyWithValue("ipv4", HaveKeyWithValue("autoDetectionMethod", "first-found")),
+					HaveKeyWithValue("nonPrivileged", true),
+				))
+				Expect(values["workerPools"]).To(ConsistOf(
+					And(
+						HaveKeyWithValue("name", "ebpf"),
+						HaveKeyWithValue("config", And(
+							HaveKeyWithValue("felix", HaveKeyWithValue("bpf", HaveKeyWithValue("enabled", true))),
+							HaveKeyWithValue("ipv4", HaveKeyWithValue("autoDetectionMethod", "first-found")),
+							HaveKeyWithValue("nonPrivileged", false),
+						)),
+						Not(HaveKey("vethMTU")),
+					),
+					And(
+						HaveKeyWithValue("name", "jumbo"),
+						HaveKeyWithValue("config", And(
+							HaveKeyWithValue("felix", HaveKeyWithValue("bpf", HaveKeyWithValue("enabled", false))),
+							HaveKeyWithValue("ipv4", HaveKeyWithValue("autoDetectionMethod", "interface=eth1")),
+							HaveKeyWithValue("veth_mtu", defaultMTU),
+						)),
+						HaveKeyWithValue("vethMTU", "8950"),
+					),
+				))
+			})
+			It("should not pass worker pools if none are configured", func() {
+				values, err := ComputeCalicoChartValues(network, &calicov1alpha1.NetworkConfig{}, kubernetesVersion, false, true, nil, false, nil, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values).NotTo(HaveKey("workerPools"))
+			})
+		})
+	})
+
+	Describe("#ComputeNetworkStatus", func() {
+		BeforeEach(func() {
+			network = &extensionsv1alpha1.Network{
+				Spec: extensionsv1alpha1.NetworkSpec{
+					IPFamilies: []extensionsv1alpha1.IPFamily{
+						extensionsv1alpha1.IPFamilyIPv4,
+					},
+					PodCIDR: string(podCIDR),
+				},
+			}
+		})
+		It("should compute the status with the defaults", func() {
+			status, err := ComputeNetworkStatus(network, nil, true, nil, false, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status).To(Equal(&calicov1alpha1.NetworkStatus{
+				Backend:        &backendBird,
+				IPAM:           pointer(calicov1alpha1.IPAMHostLocal),
+				Dataplane:      pointer(calicov1alpha1.DataplaneIPTables),
+				OverlayEnabled: true,
+				IPv4: &calicov1alpha1.IPFamilyStatus{
+					Pool:      calicov1alpha1.PoolIPIP,
+					Mode:      calicov1alpha1.Always,
+					BlockSize: 26,
+				},
+			}))
+		})
+		It("should compute the status for vxlan and eBPF", func() {
+			config := &calicov1alpha1.NetworkConfig{
+				Backend:       &backendVXLan,
+				EbpfDataplane: &calicov1alpha1.EbpfDataplane{Enabled: true},
+				VXLAN:         &calicov1alpha1.VXLAN{Enabled: true},
+				IPv4:          &calicov1alpha1.IPv4{AutoDetectionMethod: &autodetectionMethod, BlockSize: pointer[int32](24)},
+			}
+			status, err := ComputeNetworkStatus(network, config, false, nil, false, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status).To(Equal(&calicov1alpha1.NetworkStatus{
+				Backend:        &backendVXLan,
+				IPAM:           pointer(calicov1alpha1.IPAMCalico),
+				Dataplane:      pointer(calicov1alpha1.DataplaneEBPF),
+				OverlayEnabled: true,
+				VXLANEnabled:   true,
+				IPv4: &calicov1alpha1.IPFamilyStatus{
+					Pool:                calicov1alpha1.PoolVXLan,
+					Mode:                calicov1alpha1.Always,
+					AutoDetectionMethod: &autodetectionMethod,
+					BlockSize:           24,
+				},
+			}))
+		})
+		It("should compute the status for IPv6 without overlay and nftables", func() {
+			network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}
+			status, err := ComputeNetworkStatus(network, &calicov1alpha1.NetworkConfig{Backend: &backendNone}, true, pointer(corev1beta1.ProxyModeNFTables), false, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status).To(Equal(&calicov1alpha1.NetworkStatus{
+				Backend:   &backendNone,
+				IPAM:      pointer(calicov1alpha1.IPAMHostLocal),
+				Dataplane: pointer(calicov1alpha1.DataplaneNFTables),
+				IPv6: &calicov1alpha1.IPFamilyStatus{
+					Pool:      calicov1alpha1.PoolVXLan,
+					Mode:      calicov1alpha1.Never,
+					BlockSize: 122,
+				},
+			}))
+		})
+		It("should record an explicit veth MTU", func() {
+			status, err := ComputeNetworkStatus(network, &calicov1alpha1.NetworkConfig{VethMTU: pointer("1430")}, true, nil, false, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(status.VethMTU).To(Equal(pointer("1430")))
+		})
 	})
 
+	DescribeTable("#RecommendedVethMTU",
+		func(status *calicov1alpha1.NetworkStatus, wireguard bool, expected int32) {
+			Expect(RecommendedVethMTU(1500, status, wireguard)).To(Equal(expected))
+		},
+
+		Entry("should subtract nothing without overlay", &calicov1alpha1.NetworkStatus{
+			IPv4: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolIPIP, Mode: calicov1alpha1.Never},
+		}, false, int32(1500)),
+		Entry("should subtract the ipip overhead", &calicov1alpha1.NetworkStatus{
+			IPv4: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolIPIP, Mode: calicov1alpha1.Always},
+		}, false, int32(1480)),
+		Entry("should subtract the vxlan overhead", &calicov1alpha1.NetworkStatus{
+			IPv4: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolVXLan, Mode: calicov1alpha1.CrossSubnet},
+		}, false, int32(1450)),
+		Entry("should subtract the IPv6 vxlan overhead in dual-stack", &calicov1alpha1.NetworkStatus{
+			IPv4: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolIPIP, Mode: calicov1alpha1.Always},
+			IPv6: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolVXLan, Mode: calicov1alpha1.Always},
+		}, false, int32(1430)),
+		Entry("should subtract the wireguard overhead", &calicov1alpha1.NetworkStatus{
+			IPv4: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolIPIP, Mode: calicov1alpha1.Always},
+		}, true, int32(1440)),
+		Entry("should subtract the IPv6 wireguard overhead", &calicov1alpha1.NetworkStatus{
+			IPv6: &calicov1alpha1.IPFamilyStatus{Pool: calicov1alpha1.PoolVXLan, Mode: calicov1alpha1.Never},
+		}, true, int32(1420)),
+	)
+
 	Describe("#RenderCalicoChart", func() {
 		var (
 			ctrl                *gomock.Controller
@@ -781,7 +1516,7 @@ var _ = Describe("Chart package test", func() {
 					},
 				}, nil)
 
-				_, err := RenderCalicoChart(mockChartRenderer, network, networkConfigNil, kubernetesVersion, false, true, nil, false, nodes, nil, nil)
+				_, err := RenderCalicoChart(mockChartRenderer, network, networkConfigNil, kubernetesVersion, false, true, nil, false, nodes, nil, nil, nil, "")
 				Expect(err).NotTo(HaveOccurred())
 
 			},
@@ -789,6 +1524,35 @@ var _ = Describe("Chart package test", func() {
 			Entry("with node cidr", &nodeCIDR),
 			Entry("without node cidr", nil),
 		)
+
+		DescribeTable("should render the encapsulation mode of the default IPv4 pool",
+			func(providerConfig *runtime.RawExtension, config *calicov1alpha1.NetworkConfig, migration *calicov1alpha1.MigrationStatus, ipipMode string) {
+				network.Spec.ProviderConfig = providerConfig
+				renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v" + kubernetesVersion})
+
+				manifest, err := RenderCalicoChart(renderer, network, config, kubernetesVersion, false, true, nil, false, &nodeCIDR, nil, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, migration, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifest)).To(And(
+					ContainSubstring("name: default-ipv4-ippool"),
+					ContainSubstring(fmt.Sprintf("ipipMode: %q", ipipMode)),
+					ContainSubstring(`vxlanMode: "Never"`),
+				))
+			},
+
+			Entry("always encapsulated for the v1alpha1 API",
+				nil, &calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: true}, IPv4: &calicov1alpha1.IPv4{Mode: pointer(calicov1alpha1.CrossSubnet)}}, nil, "Always"),
+			Entry("always encapsulated for the v1alpha1 API with the deprecated mode Off",
+				nil, &calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: true}, IPIP: pointer(calicov1alpha1.Off)}, nil, "Always"),
+			Entry("explicitly set for the v1alpha2 API",
+				&runtime.RawExtension{Raw: []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha2","kind":"NetworkConfig"}`)},
+				&calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: true}, IPv4: &calicov1alpha1.IPv4{Mode: pointer(calicov1alpha1.CrossSubnet)}}, nil, "CrossSubnet"),
+			Entry("mapping Off to Never for the v1alpha2 API",
+				&runtime.RawExtension{Raw: []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha2","kind":"NetworkConfig"}`)},
+				&calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: true}, IPv4: &calicov1alpha1.IPv4{Mode: pointer(calicov1alpha1.Off)}}, nil, "Never"),
+			Entry("unencapsulated while preparing the overlay enablement",
+				nil, &calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: true}},
+				&calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeOverlayEnablement, Phase: calicov1alpha1.MigrationPhasePreparing}, "Never"),
+		)
 	})
 })
 
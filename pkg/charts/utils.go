@@ -10,13 +10,17 @@ import (
 	"net"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-networking-calico/imagevector"
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
 
@@ -24,35 +28,54 @@ const (
 	usePodCIDR   = "usePodCidr"
 	usePodCIDRv6 = "usePodCidrIPv6"
 	defaultMTU   = "0"
+
+	// The overheads of the encapsulations of the pod traffic between nodes, see
+	// https://docs.tigera.io/calico/latest/networking/configuring/mtu#determine-mtu-size.
+	ipipOverhead          = 20
+	vxlanIPv4Overhead     = 50
+	vxlanIPv6Overhead     = 70
+	wireguardIPv4Overhead = 60
+	wireguardIPv6Overhead = 80
 )
 
 type calicoConfig struct {
-	Backend         calicov1alpha1.Backend `json:"backend"`
-	Felix           felix                  `json:"felix"`
-	IPv4            ipv4                   `json:"ipv4"`
-	IPv6            ipv6                   `json:"ipv6"`
-	IPAM            ipam                   `json:"ipam"`
-	Typha           typha                  `json:"typha"`
-	KubeControllers kubeControllers        `json:"kubeControllers"`
-	VethMTU         string                 `json:"veth_mtu"`
-	Monitoring      monitoring             `json:"monitoring"`
-	NonPrivileged   bool                   `json:"nonPrivileged"`
-	BirdExporter    birdExporter           `json:"birdExporter"`
-	Multus          multus                 `json:"multus"`
+	Backend              calicov1alpha1.Backend    `json:"backend"`
+	Felix                felix                     `json:"felix"`
+	IPv4                 ipv4                      `json:"ipv4"`
+	IPv6                 ipv6                      `json:"ipv6"`
+	IPAM                 ipam                      `json:"ipam"`
+	Typha                typha                     `json:"typha"`
+	KubeControllers      kubeControllers           `json:"kubeControllers"`
+	VethMTU              string                    `json:"veth_mtu"`
+	Monitoring           monitoring                `json:"monitoring"`
+	NonPrivileged        bool                      `json:"nonPrivileged"`
+	BirdExporter         birdExporter              `json:"birdExporter"`
+	Multus               multus                    `json:"multus"`
+	BGP                  *bgp                      `json:"bgp,omitempty"`
+	IPPools              []ipPool                  `json:"ipPools,omitempty"`
+	Wireguard            *calicov1alpha1.Wireguard `json:"wireguard,omitempty"`
+	WireguardKeyRotation string                    `json:"wireguardKeyRotation,omitempty"`
 }
 
 type felix struct {
 	IPInIP                      felixIPinIP                           `json:"ipinip"`
+	VXLAN                       felixVXLAN                            `json:"vxlan"`
 	BPF                         felixBPF                              `json:"bpf"`
 	BPFKubeProxyIptablesCleanup felixBPFKubeProxyIptablesCleanup      `json:"bpfKubeProxyIPTablesCleanup"`
 	NFTables                    felixNFTables                         `json:"nftables"`
 	ServiceLoopPrevention       *calicov1alpha1.ServiceLoopPrevention `json:"serviceLoopPrevention,omitempty"`
+	Env                         map[string]string                     `json:"env,omitempty"`
 }
 
 type felixIPinIP struct {
 	Enabled bool `json:"enabled"`
 }
 
+type felixVXLAN struct {
+	Enabled   bool `json:"enabled"`
+	EnabledV6 bool `json:"enabledV6"`
+}
+
 type felixBPF struct {
 	Enabled bool `json:"enabled"`
 }
@@ -69,17 +92,21 @@ type ipv4 struct {
 	Enabled             bool                    `json:"enabled"`
 	Pool                calicov1alpha1.Pool     `json:"pool"`
 	Mode                calicov1alpha1.PoolMode `json:"mode"`
+	EncapsulationMode   calicov1alpha1.PoolMode `json:"encapsulationMode"`
 	AutoDetectionMethod *string                 `json:"autoDetectionMethod"`
 	Wireguard           bool                    `json:"wireguard"`
+	BlockSize           *int32                  `json:"blockSize,omitempty"`
 }
 
 type ipv6 struct {
 	Enabled             bool                    `json:"enabled"`
 	Pool                calicov1alpha1.Pool     `json:"pool"`
 	Mode                calicov1alpha1.PoolMode `json:"mode"`
+	VXLANMode           calicov1alpha1.PoolMode `json:"vxlanMode"`
 	AutoDetectionMethod *string                 `json:"autoDetectionMethod"`
 	NATOutgoing         bool                    `json:"natOutgoing"`
 	Wireguard           bool                    `json:"wireguard"`
+	BlockSize           *int32                  `json:"blockSize,omitempty"`
 }
 
 type ipam struct {
@@ -88,6 +115,14 @@ type ipam struct {
 	Ranges     [][]ipamRange `json:"ranges"`
 	AssignIPv4 bool          `json:"assign_ipv4"`
 	AssignIPv6 bool          `json:"assign_ipv6"`
+	Config     *ipamConfig   `json:"config,omitempty"`
+	Upgrade    bool          `json:"upgrade,omitempty"`
+}
+
+type ipamConfig struct {
+	StrictAffinity     bool  `json:"strictAffinity"`
+	MaxBlocksPerHost   int32 `json:"maxBlocksPerHost"`
+	AutoAllocateBlocks bool  `json:"autoAllocateBlocks"`
 }
 
 type ipamRange struct {
@@ -121,6 +156,37 @@ type multus struct {
 	InstallCNIPlugins bool `json:"installCNIPlugins"`
 }
 
+type bgp struct {
+	ASNumber              *uint32   `json:"asNumber,omitempty"`
+	NodeToNodeMeshEnabled bool      `json:"nodeToNodeMeshEnabled"`
+	Peers                 []bgpPeer `json:"peers,omitempty"`
+	// RouteReflectorSelector selects the nodes all other nodes peer with as route reflectors.
+	RouteReflectorSelector *string  `json:"routeReflectorSelector,omitempty"`
+	ServiceClusterIPs      []string `json:"serviceClusterIPs,omitempty"`
+	ServiceExternalIPs     []string `json:"serviceExternalIPs,omitempty"`
+	ServiceLoadBalancerIPs []string `json:"serviceLoadBalancerIPs,omitempty"`
+}
+
+type bgpPeer struct {
+	Name         string  `json:"name"`
+	PeerIP       *string `json:"peerIP,omitempty"`
+	ASNumber     *uint32 `json:"asNumber,omitempty"`
+	NodeSelector *string `json:"nodeSelector,omitempty"`
+	PeerSelector *string `json:"peerSelector,omitempty"`
+}
+
+type ipPool struct {
+	Name              string                            `json:"name"`
+	CIDR              string                            `json:"cidr"`
+	BlockSize         int32                             `json:"blockSize"`
+	IPIPMode          calicov1alpha1.PoolMode           `json:"ipipMode"`
+	VXLANMode         calicov1alpha1.PoolMode           `json:"vxlanMode"`
+	NATOutgoing       bool                              `json:"natOutgoing"`
+	NodeSelector      string                            `json:"nodeSelector"`
+	NamespaceSelector *string                           `json:"namespaceSelector,omitempty"`
+	AllowedUses       []calicov1alpha1.IPPoolAllowedUse `json:"allowedUses"`
+}
+
 var defaultCalicoConfig = calicoConfig{
 	Backend: calicov1alpha1.Bird,
 	Felix: felix{
@@ -193,11 +259,16 @@ func ComputeCalicoChartValues(
 	nodeCIDR *string,
 	podCIDRs []string,
 	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+	wireguardKeyRotation string,
 ) (map[string]interface{}, error) {
-	typedConfig, err := generateChartValues(network, config, kubeProxyEnabled, kubeProxyMode, nonPrivileged, ipFamilies)
+	typedConfig, err := generateChartValues(network, config, kubeProxyEnabled, kubeProxyMode, nonPrivileged, ipFamilies, migration)
 	if err != nil {
 		return nil, fmt.Errorf("error when generating calico config: %v", err)
 	}
+	if typedConfig.IPv4.Wireguard {
+		typedConfig.WireguardKeyRotation = wireguardKeyRotation
+	}
 	calicoCfg, err := typedConfig.toMap()
 	if err != nil {
 		return nil, fmt.Errorf("could not convert calico config: %v", err)
@@ -223,6 +294,14 @@ func ComputeCalicoChartValues(
 		"config": calicoCfg,
 	}
 
+	if config != nil && len(config.WorkerPools) > 0 {
+		workerPools, err := computeWorkerPoolValues(typedConfig, config.WorkerPools)
+		if err != nil {
+			return nil, err
+		}
+		calicoChartValues["workerPools"] = workerPools
+	}
+
 	for _, podCIDR := range podCIDRs {
 		_, cidr, err := net.ParseCIDR(podCIDR)
 		if err != nil {
@@ -239,7 +318,7 @@ func ComputeCalicoChartValues(
 
 	if config != nil && config.Overlay != nil {
 		calicoChartValues["global"].(map[string]string)["overlayEnabled"] = strconv.FormatBool(config.Overlay.Enabled)
-		if config.Overlay.Enabled && config.VXLAN != nil && config.VXLAN.Enabled {
+		if config.Overlay.Enabled && config.VXLAN != nil && config.VXLAN.Enabled && !isMigrationPreparing(migration, calicov1alpha1.MigrationTypeIPIPToVXLAN) {
 			calicoChartValues["global"].(map[string]string)["vxlanEnabled"] = strconv.FormatBool(config.VXLAN.Enabled)
 		}
 	}
@@ -266,7 +345,124 @@ func ComputeCalicoChartValues(
 	return calicoChartValues, nil
 }
 
-func generateChartValues(network *extensionsv1alpha1.Network, config *calicov1alpha1.NetworkConfig, kubeProxyEnabled bool, kubeProxyMode *v1beta1.ProxyMode, nonPrivileged bool, ipFamilies []extensionsv1alpha1.IPFamily) (*calicoConfig, error) {
+// computeWorkerPoolValues computes the values of the calico-node DaemonSets of the worker pools with individual
+// settings. They are based on the calico config of the shoot, overridden by the settings of the worker pool.
+func computeWorkerPoolValues(c *calicoConfig, workerPools []calicov1alpha1.WorkerPool) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(workerPools))
+	for _, workerPool := range workerPools {
+		poolConfig := *c
+		if workerPool.EbpfDataplane != nil {
+			poolConfig.Felix.BPF.Enabled = workerPool.EbpfDataplane.Enabled
+			if workerPool.EbpfDataplane.Enabled {
+				poolConfig.NonPrivileged = false
+			}
+		}
+		if workerPool.IPv4AutoDetectionMethod != nil {
+			poolConfig.IPv4.AutoDetectionMethod = workerPool.IPv4AutoDetectionMethod
+		}
+		if workerPool.IPv6AutoDetectionMethod != nil {
+			poolConfig.IPv6.AutoDetectionMethod = workerPool.IPv6AutoDetectionMethod
+		}
+
+		poolCfg, err := poolConfig.toMap()
+		if err != nil {
+			return nil, fmt.Errorf("could not convert calico config of worker pool %s: %w", workerPool.Name, err)
+		}
+		poolValues := map[string]interface{}{
+			"name":   workerPool.Name,
+			"config": poolCfg,
+		}
+		// The calico-config ConfigMap is shared by all DaemonSets, hence the MTU of the worker pool is set directly.
+		if workerPool.VethMTU != nil {
+			poolValues["vethMTU"] = *workerPool.VethMTU
+		}
+		values = append(values, poolValues)
+	}
+	return values, nil
+}
+
+// ComputeNetworkStatus computes the effective calico configuration for the network status. It is based on the same
+// values as the calico chart, so that the status reflects the deployed configuration.
+func ComputeNetworkStatus(
+	network *extensionsv1alpha1.Network,
+	config *calicov1alpha1.NetworkConfig,
+	kubeProxyEnabled bool,
+	kubeProxyMode *v1beta1.ProxyMode,
+	nonPrivileged bool,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+) (*calicov1alpha1.NetworkStatus, error) {
+	c, err := generateChartValues(network, config, kubeProxyEnabled, kubeProxyMode, nonPrivileged, ipFamilies, migration)
+	if err != nil {
+		return nil, fmt.Errorf("error when generating calico config: %v", err)
+	}
+
+	dataplane := calicov1alpha1.DataplaneIPTables
+	switch {
+	case c.Felix.BPF.Enabled:
+		dataplane = calicov1alpha1.DataplaneEBPF
+	case c.Felix.NFTables.Enabled:
+		dataplane = calicov1alpha1.DataplaneNFTables
+	}
+
+	status := &calicov1alpha1.NetworkStatus{
+		Backend:   &c.Backend,
+		IPAM:      &c.IPAM.IPAMType,
+		Dataplane: &dataplane,
+	}
+	if c.VethMTU != defaultMTU {
+		status.VethMTU = &c.VethMTU
+	}
+	if c.IPv4.Enabled {
+		status.IPv4 = &calicov1alpha1.IPFamilyStatus{
+			Pool:                c.IPv4.Pool,
+			Mode:                c.IPv4.EncapsulationMode,
+			AutoDetectionMethod: c.IPv4.AutoDetectionMethod,
+			BlockSize:           ptr.Deref(c.IPv4.BlockSize, 26),
+		}
+	}
+	if c.IPv6.Enabled {
+		status.IPv6 = &calicov1alpha1.IPFamilyStatus{
+			Pool:                c.IPv6.Pool,
+			Mode:                c.IPv6.VXLANMode,
+			AutoDetectionMethod: c.IPv6.AutoDetectionMethod,
+			BlockSize:           ptr.Deref(c.IPv6.BlockSize, 122),
+		}
+	}
+	for _, family := range []*calicov1alpha1.IPFamilyStatus{status.IPv4, status.IPv6} {
+		if family != nil && family.Mode != calicov1alpha1.Never && family.Mode != calicov1alpha1.Off {
+			status.OverlayEnabled = true
+			status.VXLANEnabled = status.VXLANEnabled || family.Pool == calicov1alpha1.PoolVXLan
+		}
+	}
+
+	return status, nil
+}
+
+// RecommendedVethMTU returns the MTU of the pod interfaces for the given MTU of the node network. It subtracts the
+// largest overhead of the encapsulations in the given network status and of WireGuard, if enabled.
+func RecommendedVethMTU(underlayMTU int32, status *calicov1alpha1.NetworkStatus, wireguard bool) int32 {
+	var overhead int32
+	if family := status.IPv4; family != nil && family.Mode != calicov1alpha1.Never && family.Mode != calicov1alpha1.Off {
+		overhead = ipipOverhead
+		if family.Pool == calicov1alpha1.PoolVXLan {
+			overhead = vxlanIPv4Overhead
+		}
+	}
+	if family := status.IPv6; family != nil && family.Mode != calicov1alpha1.Never && family.Mode != calicov1alpha1.Off {
+		overhead = max(overhead, vxlanIPv6Overhead)
+	}
+	if wireguard {
+		if status.IPv6 != nil {
+			overhead = max(overhead, wireguardIPv6Overhead)
+		} else {
+			overhead = max(overhead, wireguardIPv4Overhead)
+		}
+	}
+	return underlayMTU - overhead
+}
+
+func generateChartValues(network *extensionsv1alpha1.Network, config *calicov1alpha1.NetworkConfig, kubeProxyEnabled bool, kubeProxyMode *v1beta1.ProxyMode, nonPrivileged bool, ipFamilies []extensionsv1alpha1.IPFamily, migration *calicov1alpha1.MigrationStatus) (*calicoConfig, error) {
 	isIPv4 := slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4)
 	isIPv6 := slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6)
 
@@ -278,6 +474,7 @@ func generateChartValues(network *extensionsv1alpha1.Network, config *calicov1al
 			Enabled:             true,
 			Pool:                calicov1alpha1.PoolIPIP,
 			Mode:                calicov1alpha1.Always,
+			EncapsulationMode:   calicov1alpha1.Always,
 			AutoDetectionMethod: nil,
 		}
 	}
@@ -285,11 +482,11 @@ func generateChartValues(network *extensionsv1alpha1.Network, config *calicov1al
 	if isIPv6 {
 		c.IPAM.AssignIPv6 = true
 		c.IPAM.Subnet = usePodCIDRv6
-		c.IPAM.IPAMType = calicov1alpha1.IPAMHostLocal
 		c.IPv6 = ipv6{
 			Enabled:             true,
 			Pool:                calicov1alpha1.PoolVXLan,
 			Mode:                calicov1alpha1.Never,
+			VXLANMode:           calicov1alpha1.Never,
 			AutoDetectionMethod: nil,
 			NATOutgoing:         false,
 		}
@@ -321,7 +518,89 @@ func generateChartValues(network *extensionsv1alpha1.Network, config *calicov1al
 	// will be overridden to false if config.EbpfDataplane.Enabled==true
 	c.NonPrivileged = nonPrivileged
 
-	return mergeCalicoValuesWithConfig(&c, config, isIPv4, isIPv6)
+	merged, err := mergeCalicoValuesWithConfig(&c, config, isIPv4, isIPv6)
+	if err != nil {
+		return nil, err
+	}
+	if err := setIPv4EncapsulationMode(merged, network, config); err != nil {
+		return nil, err
+	}
+
+	applyMigration(merged, migration)
+	return merged, nil
+}
+
+// applyMigration adjusts the calico config to the transitional state of an ongoing migration of the pod network, in
+// which the nodes are prepared for the desired configuration while the pod traffic is still handled the previous way.
+func applyMigration(c *calicoConfig, migration *calicov1alpha1.MigrationStatus) {
+	switch {
+	case isMigrationPreparing(migration, calicov1alpha1.MigrationTypeOverlayEnablement):
+		// Keep the pod traffic unencapsulated until the tunnel interfaces are ready on all nodes.
+		if c.IPv4.Enabled {
+			c.IPv4.Mode = calicov1alpha1.Never
+			c.IPv4.EncapsulationMode = calicov1alpha1.Never
+		}
+		if c.IPv6.Enabled && c.IPv6.VXLANMode != calicov1alpha1.Never {
+			c.IPv6.VXLANMode = calicov1alpha1.Never
+			c.Felix.VXLAN.EnabledV6 = true
+		}
+	case isMigrationPreparing(migration, calicov1alpha1.MigrationTypeIPIPToVXLAN) && c.IPv4.Enabled:
+		// Keep the pod traffic IPIP encapsulated and the routes distributed by bird, while felix already sets up the
+		// VXLAN tunnel interfaces on all nodes.
+		c.IPv4.Pool = calicov1alpha1.PoolIPIP
+		c.Felix.IPInIP.Enabled = true
+		c.Felix.VXLAN.Enabled = true
+		if c.Backend == calicov1alpha1.VXLan {
+			c.Backend = calicov1alpha1.Bird
+		}
+	case isMigrationPreparing(migration, calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM) && c.IPv4.Enabled:
+		// Keep host-local until the IPAM blocks covering the pod CIDRs of all nodes have been created.
+		c.IPAM.IPAMType = calicov1alpha1.IPAMHostLocal
+	case migration != nil && migration.Type == calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM && c.IPv4.Enabled:
+		// Import the addresses allocated by host-local into the IPAM blocks when the calico-node pods are recycled.
+		c.IPAM.Upgrade = true
+	case migration != nil && migration.Type == calicov1alpha1.MigrationTypeIPTablesToEBPF:
+		// Keep the rules of kube-proxy until the eBPF dataplane is active on all nodes.
+		c.Felix.BPFKubeProxyIptablesCleanup.Enabled = false
+	case isMigrationPreparing(migration, calicov1alpha1.MigrationTypeIPv4ToDualStack) && c.IPv4.Enabled && c.IPv6.Enabled:
+		// Keep assigning IPv4 addresses only until calico is ready for IPv6 on all nodes.
+		c.IPAM.AssignIPv6 = false
+		c.IPAM.Ranges = nil
+		if c.IPAM.IPAMType == calicov1alpha1.IPAMHostLocal {
+			c.IPAM.Subnet = usePodCIDR
+		}
+	case isMigrationPreparing(migration, calicov1alpha1.MigrationTypeEBPFToIPTables):
+		// Keep the eBPF dataplane until kube-proxy is ready on all nodes.
+		c.Felix.BPF.Enabled = true
+		c.NonPrivileged = false
+	}
+}
+
+// setIPv4EncapsulationMode sets the encapsulation mode of the default IPv4 pool. The v1alpha1 API derives it from the
+// overlay settings, i.e. the pod traffic is always encapsulated if the overlay is enabled, while the v1alpha2 API sets it
+// explicitly. Calico IP pools do not know the mode Off, it is equivalent to Never.
+func setIPv4EncapsulationMode(c *calicoConfig, network *extensionsv1alpha1.Network, config *calicov1alpha1.NetworkConfig) error {
+	c.IPv4.EncapsulationMode = c.IPv4.Mode
+	if c.IPv4.EncapsulationMode == calicov1alpha1.Off {
+		c.IPv4.EncapsulationMode = calicov1alpha1.Never
+	}
+
+	if config == nil || config.Overlay == nil || !config.Overlay.Enabled {
+		return nil
+	}
+	derivesPoolModeFromOverlay, err := calicov1alpha1helper.DerivesBackendFromOverlay(network.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+	if derivesPoolModeFromOverlay {
+		c.IPv4.EncapsulationMode = calicov1alpha1.Always
+	}
+	return nil
+}
+
+// isMigrationPreparing returns true if the given migration of the given type is in the preparing phase.
+func isMigrationPreparing(migration *calicov1alpha1.MigrationStatus, migrationType calicov1alpha1.MigrationType) bool {
+	return migration != nil && migration.Type == migrationType && migration.Phase == calicov1alpha1.MigrationPhasePreparing
 }
 
 func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.NetworkConfig, isIPv4, isIPv6 bool) (*calicoConfig, error) {
@@ -333,10 +612,13 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		c.Felix.ServiceLoopPrevention = config.ServiceLoopPrevention
 	}
 
+	c.Felix.Env = felixEnv(config.Felix)
+
 	c.IPv4.Wireguard = config.WireguardEncryption
 	c.IPv6.Wireguard = config.WireguardEncryption
 	if config.WireguardEncryption {
 		c.IPv6.NATOutgoing = true
+		c.Wireguard = config.Wireguard
 	}
 
 	if config.Backend != nil {
@@ -373,6 +655,19 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		if config.IPAM != nil && config.IPAM.CIDR != nil && (!isIPv4 || !isIPv6) {
 			c.IPAM.Subnet = string(*config.IPAM.CIDR)
 		}
+	} else if isIPv6 {
+		// calico-ipam allocates the addresses from the blocks of the IP pools, the pod CIDRs of the nodes are only used
+		// by host-local.
+		c.IPAM.Subnet = ""
+		c.IPAM.Ranges = nil
+	}
+
+	if config.IPAM != nil && config.IPAM.Config != nil {
+		c.IPAM.Config = &ipamConfig{
+			StrictAffinity:     ptr.Deref(config.IPAM.Config.StrictAffinity, false),
+			MaxBlocksPerHost:   ptr.Deref(config.IPAM.Config.MaxBlocksPerHost, 0),
+			AutoAllocateBlocks: ptr.Deref(config.IPAM.Config.AutoAllocateBlocks, true),
+		}
 	}
 
 	if config.IPv4 != nil {
@@ -383,7 +678,10 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		if config.VXLAN != nil && config.VXLAN.Enabled {
 			c.IPv4.Pool = calicov1alpha1.PoolVXLan
 			c.IPv4.Mode = calicov1alpha1.Always
-			c.IPAM.IPAMType = calicov1alpha1.IPAMCalico
+			// VXLAN implies calico-ipam, unless the IPAM type is set explicitly.
+			if config.IPAM == nil || config.IPAM.Type == "" {
+				c.IPAM.IPAMType = calicov1alpha1.IPAMCalico
+			}
 		}
 
 		if config.IPv4.Pool != nil {
@@ -405,6 +703,7 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		if config.IPv4.AutoDetectionMethod != nil {
 			c.IPv4.AutoDetectionMethod = config.IPv4.AutoDetectionMethod
 		}
+		c.IPv4.BlockSize = config.IPv4.BlockSize
 	} else {
 		// fallback to deprecated configuration fields
 		// will be removed in a future Gardener release
@@ -440,10 +739,16 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		if config.IPv6.Mode != nil {
 			switch *config.IPv6.Mode {
 			case calicov1alpha1.Always, calicov1alpha1.Never, calicov1alpha1.CrossSubnet:
+				// Only an explicit mode encapsulates the IPv6 pod traffic with VXLAN.
 				c.IPv6.Mode = *config.IPv6.Mode
+				c.IPv6.VXLANMode = *config.IPv6.Mode
 			default:
 				return nil, fmt.Errorf("unsupported value for ipv6 mode: %s", *config.IPv6.Mode)
 			}
+		} else if config.Overlay != nil && config.Overlay.Enabled {
+			// calico-node is told the mode of the overlay network, which it only uses if it creates the default IPv6 pool
+			// itself. The pool is managed by the extension instead and does not encapsulate the IPv6 pod traffic.
+			c.IPv6.Mode = calicov1alpha1.Always
 		}
 		if config.IPv6.AutoDetectionMethod != nil {
 			c.IPv6.AutoDetectionMethod = config.IPv6.AutoDetectionMethod
@@ -451,6 +756,7 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		if config.IPv6.SourceNATEnabled != nil {
 			c.IPv6.NATOutgoing = *config.IPv6.SourceNATEnabled
 		}
+		c.IPv6.BlockSize = config.IPv6.BlockSize
 	}
 
 	if config.Typha != nil {
@@ -472,9 +778,133 @@ func mergeCalicoValuesWithConfig(c *calicoConfig, config *calicov1alpha1.Network
 		}
 	}
 
+	if config.BGP != nil {
+		if c.Backend != calicov1alpha1.Bird {
+			return nil, fmt.Errorf("BGP configuration is only supported with backend %s", calicov1alpha1.Bird)
+		}
+		c.BGP = &bgp{
+			ASNumber: config.BGP.ASNumber,
+			// the full mesh is not needed if the nodes peer with route reflectors
+			NodeToNodeMeshEnabled: config.BGP.RouteReflector == nil,
+		}
+		if config.BGP.NodeToNodeMeshEnabled != nil {
+			c.BGP.NodeToNodeMeshEnabled = *config.BGP.NodeToNodeMeshEnabled
+		}
+		for _, peer := range config.BGP.Peers {
+			c.BGP.Peers = append(c.BGP.Peers, bgpPeer{
+				Name:         peer.Name,
+				PeerIP:       peer.PeerIP,
+				ASNumber:     peer.ASNumber,
+				NodeSelector: peer.NodeSelector,
+				PeerSelector: peer.PeerSelector,
+			})
+		}
+		if config.BGP.RouteReflector != nil {
+			c.BGP.RouteReflectorSelector = &config.BGP.RouteReflector.NodeSelector
+		}
+		if config.BGP.ServiceClusterIPs != nil {
+			c.BGP.ServiceClusterIPs = cidrsToStrings(config.BGP.ServiceClusterIPs.CIDRs)
+		}
+		c.BGP.ServiceExternalIPs = cidrsToStrings(config.BGP.ServiceExternalIPs)
+		c.BGP.ServiceLoadBalancerIPs = cidrsToStrings(config.BGP.ServiceLoadBalancerIPs)
+	}
+
+	for _, pool := range config.IPPools {
+		p, err := computeIPPool(c, config, pool, isIPv4, isIPv6)
+		if err != nil {
+			return nil, err
+		}
+		c.IPPools = append(c.IPPools, *p)
+	}
+
 	return c, nil
 }
 
+// computeIPPool computes the chart values of an additional IP pool. Unset fields default to the settings
+// of the default IP pool of the same IP family.
+func computeIPPool(c *calicoConfig, config *calicov1alpha1.NetworkConfig, pool calicov1alpha1.IPPool, isIPv4, isIPv6 bool) (*ipPool, error) {
+	ip, _, err := net.ParseCIDR(string(pool.CIDR))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR for ip pool %s: %w", pool.Name, err)
+	}
+
+	p := &ipPool{
+		Name:         pool.Name,
+		CIDR:         string(pool.CIDR),
+		IPIPMode:     calicov1alpha1.Never,
+		VXLANMode:    calicov1alpha1.Never,
+		NodeSelector: "all()",
+		AllowedUses:  []calicov1alpha1.IPPoolAllowedUse{calicov1alpha1.IPPoolAllowedUseWorkload, calicov1alpha1.IPPoolAllowedUseTunnel},
+	}
+
+	encapsulation, mode := c.IPv4.Pool, c.IPv4.Mode
+	if ip.To4() != nil {
+		if !isIPv4 {
+			return nil, fmt.Errorf("ip pool %s must not use IPv4 if Shoot doesn't use IPv4 networking", pool.Name)
+		}
+		p.BlockSize = ptr.Deref(c.IPv4.BlockSize, 26)
+		p.NATOutgoing = true
+		// the default pool does not use an overlay if it is disabled, independent of the ipv4 settings
+		if config.Overlay != nil && !config.Overlay.Enabled {
+			mode = calicov1alpha1.Never
+		}
+	} else {
+		if !isIPv6 {
+			return nil, fmt.Errorf("ip pool %s must not use IPv6 if Shoot doesn't use IPv6 networking", pool.Name)
+		}
+		p.BlockSize = ptr.Deref(c.IPv6.BlockSize, 122)
+		p.NATOutgoing = c.IPv6.NATOutgoing
+		encapsulation, mode = c.IPv6.Pool, c.IPv6.VXLANMode
+	}
+
+	if pool.Encapsulation != nil {
+		encapsulation = *pool.Encapsulation
+		mode = calicov1alpha1.Always
+	}
+	if pool.Mode != nil {
+		mode = *pool.Mode
+	}
+	switch mode {
+	case calicov1alpha1.Always, calicov1alpha1.CrossSubnet:
+	case calicov1alpha1.Never, calicov1alpha1.Off:
+		mode = calicov1alpha1.Never
+	default:
+		return nil, fmt.Errorf("unsupported value for mode of ip pool %s: %s", pool.Name, mode)
+	}
+	switch encapsulation {
+	case calicov1alpha1.PoolIPIP:
+		p.IPIPMode = mode
+	case calicov1alpha1.PoolVXLan:
+		p.VXLANMode = mode
+	default:
+		return nil, fmt.Errorf("unsupported value for encapsulation of ip pool %s: %s", pool.Name, encapsulation)
+	}
+
+	if pool.BlockSize != nil {
+		p.BlockSize = *pool.BlockSize
+	}
+	if pool.NATOutgoing != nil {
+		p.NATOutgoing = *pool.NATOutgoing
+	}
+	if pool.NodeSelector != nil {
+		p.NodeSelector = *pool.NodeSelector
+	}
+	p.NamespaceSelector = pool.NamespaceSelector
+	if len(pool.AllowedUses) > 0 {
+		p.AllowedUses = pool.AllowedUses
+	}
+
+	return p, nil
+}
+
+func cidrsToStrings(cidrs []calicov1alpha1.CIDR) []string {
+	var result []string
+	for _, cidr := range cidrs {
+		result = append(result, string(cidr))
+	}
+	return result
+}
+
 func calculateResourceRequests(resources *calicov1alpha1.Resources) map[string]interface{} {
 	if resources == nil {
 		return map[string]interface{}{}
@@ -497,3 +927,91 @@ func addResourceRequestsFromConfig(resources *corev1.ResourceList, name string,
 		resourceRequests[name] = nodeRequests
 	}
 }
+
+// felixEnv returns the environment variables of calico-node for the given felix settings. They are passed as
+// environment variables instead of the FelixConfiguration named default, which calico-node creates and updates itself.
+// Environment variables take precedence over the FelixConfiguration.
+func felixEnv(configuration *calicov1alpha1.FelixConfiguration) map[string]string {
+	if configuration == nil {
+		return nil
+	}
+
+	env := map[string]string{}
+	setString := func(name string, value *string) {
+		if value != nil {
+			env[name] = *value
+		}
+	}
+	setSeconds := func(name string, value *metav1.Duration) {
+		if value != nil {
+			env[name] = strconv.FormatFloat(value.Seconds(), 'f', -1, 64)
+		}
+	}
+	setPorts := func(name string, value []calicov1alpha1.FelixProtoPort) {
+		if len(value) > 0 {
+			env[name] = felixPortList(value)
+		}
+	}
+
+	setString("FELIX_LOGSEVERITYSCREEN", configuration.LogSeverityScreen)
+	setSeconds("FELIX_ROUTEREFRESHINTERVAL", configuration.RouteRefreshInterval)
+	setSeconds("FELIX_IPTABLESREFRESHINTERVAL", configuration.IptablesRefreshInterval)
+	setSeconds("FELIX_NFTABLESREFRESHINTERVAL", configuration.NftablesRefreshInterval)
+	if timeouts := felixConntrackTimeouts(configuration.BPFConntrackTimeouts); timeouts != "" {
+		env["FELIX_BPFCONNTRACKTIMEOUTS"] = timeouts
+	}
+	setPorts("FELIX_FAILSAFEINBOUNDHOSTPORTS", configuration.FailsafeInboundHostPorts)
+	setPorts("FELIX_FAILSAFEOUTBOUNDHOSTPORTS", configuration.FailsafeOutboundHostPorts)
+	setString("FELIX_NATPORTRANGE", configuration.NATPortRange)
+	setSeconds("FELIX_FLOWLOGSFLUSHINTERVAL", configuration.FlowLogsFlushInterval)
+	setString("FELIX_FLOWLOGSPOLICYEVALUATIONMODE", configuration.FlowLogsPolicyEvaluationMode)
+
+	if len(env) == 0 {
+		return nil
+	}
+	return env
+}
+
+// felixConntrackTimeouts returns the given timeouts in the key-value list format of felix, e.g. TCPSynSent=20s.
+func felixConntrackTimeouts(timeouts *calicov1alpha1.FelixConntrackTimeouts) string {
+	if timeouts == nil {
+		return ""
+	}
+
+	var entries []string
+	for _, timeout := range []struct {
+		key   string
+		value *metav1.Duration
+	}{
+		{"CreationGracePeriod", timeouts.CreationGracePeriod},
+		{"TCPSynSent", timeouts.TCPSynSent},
+		{"TCPEstablished", timeouts.TCPEstablished},
+		{"TCPFinsSeen", timeouts.TCPFinsSeen},
+		{"TCPResetSeen", timeouts.TCPResetSeen},
+		{"UDPTimeout", timeouts.UDPTimeout},
+		{"GenericTimeout", timeouts.GenericTimeout},
+		{"ICMPTimeout", timeouts.ICMPTimeout},
+	} {
+		if timeout.value != nil {
+			entries = append(entries, fmt.Sprintf("%s=%s", timeout.key, timeout.value.Duration))
+		}
+	}
+	return strings.Join(entries, ",")
+}
+
+// felixPortList returns the given ports in the port list format of felix, e.g. tcp:22 or udp:[fd00::/8]:53.
+func felixPortList(ports []calicov1alpha1.FelixProtoPort) string {
+	entries := make([]string, 0, len(ports))
+	for _, port := range ports {
+		entry := ptr.Deref(port.Protocol, "tcp")
+		if port.Net != nil {
+			cidr := *port.Net
+			if strings.Contains(cidr, ":") {
+				cidr = "[" + cidr + "]"
+			}
+			entry += ":" + cidr
+		}
+		entries = append(entries, fmt.Sprintf("%s:%d", entry, port.Port))
+	}
+	return strings.Join(entries, ",")
+}
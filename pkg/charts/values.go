@@ -30,11 +30,18 @@ func RenderCalicoChart(
 	nodeCIDR *string,
 	podCidrs []string,
 	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+	wireguardKeyRotation string,
 ) ([]byte, error) {
-	values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, wantsVPA, kubeProxyEnabled, kubeProxyMode, nonPrivileged, nodeCIDR, podCidrs, ipFamilies)
+	values, err := ComputeCalicoChartValues(network, config, kubernetesVersion, wantsVPA, kubeProxyEnabled, kubeProxyMode, nonPrivileged, nodeCIDR, podCidrs, ipFamilies, migration, wireguardKeyRotation)
 	if err != nil {
 		return nil, err
 	}
+	return RenderCalicoChartWithValues(renderer, values)
+}
+
+// RenderCalicoChartWithValues renders the calico chart with the given values computed by ComputeCalicoChartValues.
+func RenderCalicoChartWithValues(renderer chartrenderer.Interface, values map[string]interface{}) ([]byte, error) {
 	release, err := renderer.RenderEmbeddedFS(charts.InternalChart, calico.CalicoChartPath, calico.ReleaseName, metav1.NamespaceSystem, values)
 	if err != nil {
 		return nil, err
@@ -7,8 +7,10 @@ package cmd
 import (
 	"fmt"
 
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	"github.com/spf13/pflag"
 
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 	configloader "github.com/gardener/gardener-extension-networking-calico/pkg/apis/config/loader"
 )
@@ -66,3 +68,30 @@ func (c *Config) Options() config.ControllerConfiguration {
 	c.Apply(&cfg)
 	return cfg
 }
+
+// ApplyHealthCheckConfig applies the HealthCheckConfig to the config.
+func (c *Config) ApplyHealthCheckConfig(config *extensionsconfigv1alpha1.HealthCheckConfig) {
+	if c.Config.HealthCheckConfig != nil {
+		*config = *c.Config.HealthCheckConfig
+	}
+}
+
+// ApplyUnderlayMTUs applies the UnderlayMTUs to the config.
+func (c *Config) ApplyUnderlayMTUs(underlayMTUs *map[string]int32) {
+	*underlayMTUs = c.Config.UnderlayMTUs
+}
+
+// ApplyDefaults applies the network config Defaults to the config.
+func (c *Config) ApplyDefaults(defaults *map[string]calicov1alpha1.NetworkConfig) {
+	*defaults = c.Config.Defaults
+}
+
+// ApplyConstraints applies the network config Constraints to the config.
+func (c *Config) ApplyConstraints(constraints *map[string]config.NetworkConfigConstraints) {
+	*constraints = c.Config.Constraints
+}
+
+// ApplyProfiles applies the network config Profiles to the config.
+func (c *Config) ApplyProfiles(profiles *map[string]config.NetworkConfigProfile) {
+	*profiles = c.Config.Profiles
+}
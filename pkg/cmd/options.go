@@ -6,12 +6,16 @@ package cmd
 
 import (
 	controllercmd "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	extensionshealthcheckcontroller "github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
 	extensionsheartbeatcontroller "github.com/gardener/gardener/extensions/pkg/controller/heartbeat"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/controller/healthcheck"
 )
 
 // ControllerSwitchOptions are the controllercmd.SwitchOptions for the calico network extension controllers.
 func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 	return controllercmd.NewSwitchOptions(
+		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheck.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
 	)
 }
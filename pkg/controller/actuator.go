@@ -10,10 +10,12 @@ import (
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 )
 
 var (
@@ -27,17 +29,36 @@ var (
 type actuator struct {
 	restConfig *rest.Config
 	client     client.Client
+	clock      clock.Clock
 
 	chartRendererFactory extensionscontroller.ChartRendererFactory
 	chartApplier         gardenerkubernetes.ChartApplier
+
+	underlayMTUs map[string]int32
+	defaults     map[string]calicov1alpha1.NetworkConfig
+	constraints  map[string]config.NetworkConfigConstraints
+	profiles     map[string]config.NetworkConfigProfile
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled Network resources.
-func NewActuator(mgr manager.Manager, chartApplier gardenerkubernetes.ChartApplier, chartRendererFactory extensionscontroller.ChartRendererFactory) network.Actuator {
+func NewActuator(
+	mgr manager.Manager,
+	chartApplier gardenerkubernetes.ChartApplier,
+	chartRendererFactory extensionscontroller.ChartRendererFactory,
+	underlayMTUs map[string]int32,
+	defaults map[string]calicov1alpha1.NetworkConfig,
+	constraints map[string]config.NetworkConfigConstraints,
+	profiles map[string]config.NetworkConfigProfile,
+) network.Actuator {
 	return &actuator{
 		client:               mgr.GetClient(),
 		restConfig:           mgr.GetConfig(),
+		clock:                clock.RealClock{},
 		chartApplier:         chartApplier,
 		chartRendererFactory: chartRendererFactory,
+		underlayMTUs:         underlayMTUs,
+		defaults:             defaults,
+		constraints:          constraints,
+		profiles:             profiles,
 	}
 }
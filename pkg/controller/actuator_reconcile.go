@@ -11,6 +11,7 @@ import (
 	"net"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
@@ -23,6 +24,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	gardenerkubernetes "github.com/gardener/gardener/pkg/client/kubernetes"
+	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
@@ -30,8 +32,12 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -49,6 +55,16 @@ import (
 const (
 	// CalicoConfigManagedResourceName is the name of the managed resource of networking calico
 	CalicoConfigManagedResourceName = "extension-networking-calico-config"
+
+	// annotationCalicoIPv4IPIPTunnelAddress is the node annotation set by calico-node with the IPv4 address of the ipip
+	// tunnel interface.
+	annotationCalicoIPv4IPIPTunnelAddress = "projectcalico.org/IPv4IPIPTunnelAddr"
+	// annotationCalicoIPv4VXLANTunnelAddress is the node annotation set by calico-node with the IPv4 address of the vxlan
+	// tunnel interface.
+	annotationCalicoIPv4VXLANTunnelAddress = "projectcalico.org/IPv4VXLANTunnelAddr"
+
+	// migrationRequeueInterval is the interval after which the reconciliation of an ongoing migration is requeued.
+	migrationRequeueInterval = 30 * time.Second
 )
 
 var (
@@ -111,17 +127,182 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 	if errList := validation.ValidateNetwork(network); len(errList) != 0 {
 		return fmt.Errorf("invalid network resource: %w", errList.ToAggregate())
 	}
-	var (
-		networkConfig *calicov1alpha1.NetworkConfig
-		err           error
-	)
+
+	network, networkConfig, ipFamilies, err := a.desiredNetworkConfig(network, cluster)
+	if err != nil {
+		return err
+	}
+	keepCalicoIPAM(ctx, log, a.client, network, networkConfig, ipFamilies)
+
+	shootKubernetesVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return fmt.Errorf("failed to parse shoot Kubernetes version %s: %w", cluster.Shoot.Spec.Kubernetes.Version, err)
+	}
+
+	newK8sGreaterEqual136, err := versionutils.CheckVersionMeetsConstraint(shootKubernetesVersion.String(), ">= 1.36")
+	if err != nil {
+		return fmt.Errorf("failed to check version constraint %w", err)
+	}
+
+	// MutatingAdmissionPolicy is GA (always on) starting with K8s 1.36. For earlier
+	// versions it is alpha (< 1.34) or beta (>= 1.34, < 1.36). Per KEP-3136 beta APIs
+	// are off by default, so 1.34 and 1.35 still require an explicit opt-in via the
+	// MutatingAdmissionPolicy feature gate plus a matching runtimeConfig entry.
+	mutatingAdmissionPolicyAvailable := newK8sGreaterEqual136 || isMutatingAdmissionPolicyEnabled(cluster)
+
+	var migration *calicov1alpha1.MigrationStatus
+	if features.FeatureGate.Enabled(features.SeamlessOverlaySwitch) && mutatingAdmissionPolicyAvailable {
+		overlaySwitch, err := isOverlaySwitch(ctx, log, a.client, network)
+		if err != nil {
+			return fmt.Errorf("failed to detect pod overlay switch: %w", err)
+		}
+
+		if err := a.ensureNodesRoutesBeforeOverlaySwitch(ctx, log, cluster, networkConfig, overlaySwitch); err != nil {
+			return err
+		}
+
+		overlayEnablementPhase, err := getOverlayEnablementPhase(ctx, log, a.client, network, networkConfig, ipFamilies)
+		if err != nil {
+			return fmt.Errorf("failed to detect pod overlay enablement: %w", err)
+		}
+
+		migration, err = a.ensureNodesTunnelsBeforeOverlayEnablement(ctx, log, cluster, overlayEnablementPhase, ipFamilies)
+		if err != nil {
+			return err
+		}
+	}
+
+	if features.FeatureGate.Enabled(features.SeamlessIPAMMigration) && migration == nil {
+		ipamMigrationPhase, err := getIPAMMigrationPhase(ctx, log, a.client, network, networkConfig, ipFamilies)
+		if err != nil {
+			return fmt.Errorf("failed to detect IPAM migration: %w", err)
+		}
+
+		migration, err = a.ensureIPAMMigration(ctx, log, cluster, ipamMigrationPhase)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The encapsulation is only switched once the IPAM migration is completed, as VXLAN implies calico-ipam.
+	if features.FeatureGate.Enabled(features.SeamlessEncapsulationMigration) && migration == nil {
+		vxlanMigration, err := isIPIPToVXLANMigration(ctx, log, a.client, network)
+		if err != nil {
+			return fmt.Errorf("failed to detect IPIP to VXLAN migration: %w", err)
+		}
+
+		migration, err = a.ensureNodesVXLANBeforeIPIPToVXLANMigration(ctx, log, cluster, vxlanMigration)
+		if err != nil {
+			return err
+		}
+	}
+
+	if features.FeatureGate.Enabled(features.SeamlessDataplaneMigration) && migration == nil {
+		dataplaneMigrationType, dataplaneMigrationPhase, err := getDataplaneMigration(ctx, log, a.client, network, networkConfig)
+		if err != nil {
+			return fmt.Errorf("failed to detect dataplane migration: %w", err)
+		}
+
+		migration, err = a.ensureDataplaneMigration(ctx, log, cluster, networkConfig, dataplaneMigrationType, dataplaneMigrationPhase)
+		if err != nil {
+			return err
+		}
+	}
+
+	if features.FeatureGate.Enabled(features.SeamlessDualStackMigration) && migration == nil {
+		dualStackMigrationPhase, err := getDualStackMigrationPhase(ctx, log, a.client, network, ipFamilies)
+		if err != nil {
+			return fmt.Errorf("failed to detect dual-stack migration: %w", err)
+		}
+
+		migration, err = a.ensureDualStackMigration(ctx, log, cluster, dualStackMigrationPhase)
+		if err != nil {
+			return err
+		}
+	}
+
+	var wireguardKeyRotation string
+	var lastWireguardKeyRotation *string
+	if networkConfig.WireguardEncryption {
+		wireguardKeyRotation = cluster.Shoot.Annotations[calico.AnnotationWireguardKeyRotation]
+		lastWireguardKeyRotation, err = getWireguardKeyRotationFromStatus(network)
+		if err != nil {
+			return err
+		}
+	}
+
+	if wireguardKeyRotation != "" && wireguardKeyRotation != ptr.Deref(lastWireguardKeyRotation, "") && migration == nil {
+		migration, err = a.ensureWireguardKeyRotation(ctx, log, network, cluster, wireguardKeyRotation, ipFamilies)
+		if err != nil {
+			return err
+		}
+		if migration == nil {
+			lastWireguardKeyRotation = &wireguardKeyRotation
+		}
+	}
+
+	kubeProxyEnabled, kubeProxyMode, err := a.deriveNetworkConfig(network, networkConfig, cluster, ipFamilies, migration)
+	if err != nil {
+		return err
+	}
+
+	_, calicoChart, err := a.renderCalicoChart(network, networkConfig, cluster, kubeProxyEnabled, kubeProxyMode, ipFamilies, migration, wireguardKeyRotation)
+	if err != nil {
+		return err
+	}
+
+	data := map[string][]byte{chartspkg.CalicoConfigKey: calicoChart}
+	if err := managedresources.CreateForShoot(ctx, a.client, network.Namespace, CalicoConfigManagedResourceName, "extension-networking-calico", false, data); err != nil {
+		return err
+	}
+
+	if err := applyMonitoringConfig(ctx, a.client, a.chartApplier, network, false); err != nil {
+		return err
+	}
+
+	var wireguard *calicov1alpha1.WireguardStatus
+	if networkConfig.WireguardEncryption {
+		wireguard = a.getWireguardStatus(ctx, log, cluster, ipFamilies)
+	}
+	if wireguard != nil {
+		wireguard.KeyRotation = lastWireguardKeyRotation
+	}
+
+	if err := a.updateProviderStatus(ctx, network, networkConfig, kubeProxyEnabled, kubeProxyMode, ipFamilies, migration, wireguard); err != nil {
+		return err
+	}
+
+	if wireguard != nil && len(wireguard.UnsupportedNodes) > 0 {
+		return fmt.Errorf("WireGuard encryption is enabled, but the kernel of nodes %s does not support WireGuard (Linux >= %s is required)", strings.Join(wireguard.UnsupportedNodes, ", "), minWireguardKernelVersion)
+	}
+
+	if migration != nil {
+		// Requeue instead of failing the reconciliation, so that the migration is not reported as an error.
+		return &reconcilerutils.RequeueAfterError{
+			Cause:        fmt.Errorf("waiting for all nodes before completing the %s migration (%s)", migration.Type, migrationProgress(migration)),
+			RequeueAfter: migrationRequeueInterval,
+		}
+	}
+	return nil
+}
+
+// desiredNetworkConfig returns the network with the effective provider config, the network config decoded from it and
+// the IP families calico is configured for. The network config is validated and completed with the settings derived
+// from the shoot.
+func (a *actuator) desiredNetworkConfig(network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) (*extensionsv1alpha1.Network, *calicov1alpha1.NetworkConfig, []extensionsv1alpha1.IPFamily, error) {
+	network, err := a.withEffectiveNetworkConfig(network, cluster)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var networkConfig *calicov1alpha1.NetworkConfig
 
 	ipFamilies := slices.Clone(network.Spec.IPFamilies)
 
 	if network.Spec.ProviderConfig != nil {
 		networkConfig, err = calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 
 		// Convert ipFamilies to core.IPFamily for validation
@@ -131,11 +312,13 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		}
 
 		if err := ValidateNetworkConfig(networkConfig, coreIPFamilies); err != nil {
-			return err
+			return nil, nil, nil, err
 		}
 	}
 
-	if condition := gardencorev1beta1helper.GetCondition(cluster.Shoot.Status.Constraints, v1beta1.ShootDualStackNodesMigrationReady); condition != nil && condition.Status != v1beta1.ConditionTrue {
+	// Without the seamless dual-stack migration, IPv6 is enabled as soon as the nodes are ready for it. Otherwise, the
+	// migration prepares IPv6 on all nodes and enables it for the pods once every node is ready for it.
+	if condition := gardencorev1beta1helper.GetCondition(cluster.Shoot.Status.Constraints, v1beta1.ShootDualStackNodesMigrationReady); condition != nil && condition.Status != v1beta1.ConditionTrue && !features.FeatureGate.Enabled(features.SeamlessDualStackMigration) {
 		if len(ipFamilies) > 1 {
 			ipFamilies = ipFamilies[:1]
 		}
@@ -145,6 +328,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		networkConfig = &calicov1alpha1.NetworkConfig{}
 	}
 
+	if constraints, ok := a.constraints[cluster.Shoot.Spec.Provider.Type]; ok {
+		if err := ValidateNetworkConfigConstraints(networkConfig, &constraints, cluster.Shoot.Spec.Provider.Type); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) {
 		if networkConfig.IPv4 == nil {
 			networkConfig.IPv4 = &calicov1alpha1.IPv4{}
@@ -163,7 +352,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		if cluster.Shoot.Status.Networking != nil && cluster.Shoot.Status.Networking.Nodes != nil && len(cluster.Shoot.Status.Networking.Nodes) > 0 {
 			ipv4Nodes, ipv6Nodes, err := segregateNodeCIDRs(cluster.Shoot.Status.Networking.Nodes)
 			if err != nil {
-				return err
+				return nil, nil, nil, err
 			}
 
 			autodetectionMode = updateAutoDetectionMode(ipv4Nodes)
@@ -174,34 +363,29 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		}
 	}
 
-	shootKubernetesVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
-	if err != nil {
-		return fmt.Errorf("failed to parse shoot Kubernetes version %s: %w", cluster.Shoot.Spec.Kubernetes.Version, err)
-	}
-
-	newK8sGreaterEqual136, err := versionutils.CheckVersionMeetsConstraint(shootKubernetesVersion.String(), ">= 1.36")
-	if err != nil {
-		return fmt.Errorf("failed to check version constraint %w", err)
-	}
-
-	// MutatingAdmissionPolicy is GA (always on) starting with K8s 1.36. For earlier
-	// versions it is alpha (< 1.34) or beta (>= 1.34, < 1.36). Per KEP-3136 beta APIs
-	// are off by default, so 1.34 and 1.35 still require an explicit opt-in via the
-	// MutatingAdmissionPolicy feature gate plus a matching runtimeConfig entry.
-	mutatingAdmissionPolicyAvailable := newK8sGreaterEqual136 || isMutatingAdmissionPolicyEnabled(cluster)
+	setServiceClusterIPCIDRs(networkConfig, cluster)
 
-	if features.FeatureGate.Enabled(features.SeamlessOverlaySwitch) && mutatingAdmissionPolicyAvailable {
-		overlaySwitch, err := isOverlaySwitch(ctx, log, a.client, network)
-		if err != nil {
-			return fmt.Errorf("failed to detect pod overlay switch: %w", err)
-		}
+	return network, networkConfig, ipFamilies, nil
+}
 
-		if err := a.ensureNodesRoutesBeforeOverlaySwitch(ctx, log, cluster, networkConfig, overlaySwitch); err != nil {
-			return err
-		}
+// deriveNetworkConfig derives the backend, the pool modes and the veth MTU of the given network config from its overlay
+// settings, the IP families and the provider of the shoot. It returns whether kube-proxy is enabled and its mode.
+func (a *actuator) deriveNetworkConfig(
+	network *extensionsv1alpha1.Network,
+	networkConfig *calicov1alpha1.NetworkConfig,
+	cluster *extensionscontroller.Cluster,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+) (bool, *v1beta1.ProxyMode, error) {
+	deferVXLAN(networkConfig, migration)
+
+	// The v1alpha2 API sets the backend and the pool modes explicitly instead of deriving them from the overlay settings.
+	derivesBackendFromOverlay, err := calicov1alpha1helper.DerivesBackendFromOverlay(network.Spec.ProviderConfig)
+	if err != nil {
+		return false, nil, err
 	}
 
-	if networkConfig != nil {
+	if networkConfig != nil && derivesBackendFromOverlay {
 		if networkConfig.Overlay != nil {
 			if networkConfig.Overlay.Enabled {
 				setPoolMode(networkConfig, ipFamilies, calicov1alpha1.Always)
@@ -215,7 +399,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 				}
 			}
 		} else {
-			if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) {
+			if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) && encapsulatingIPv6PoolMode(networkConfig) == nil {
 				setPoolMode(networkConfig, ipFamilies, calicov1alpha1.Never)
 			}
 		}
@@ -223,7 +407,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 
 	if cluster.Shoot.Spec.Kubernetes.KubeProxy != nil && cluster.Shoot.Spec.Kubernetes.KubeProxy.Enabled != nil && !*cluster.Shoot.Spec.Kubernetes.KubeProxy.Enabled {
 		if networkConfig == nil || networkConfig.EbpfDataplane == nil || (networkConfig.EbpfDataplane != nil && !networkConfig.EbpfDataplane.Enabled) {
-			return field.Forbidden(field.NewPath("spec", "kubernetes", "kubeProxy", "enabled"), "Disabling kube-proxy is forbidden in conjunction with calico without running in ebpf dataplane")
+			return false, nil, field.Forbidden(field.NewPath("spec", "kubernetes", "kubeProxy", "enabled"), "Disabling kube-proxy is forbidden in conjunction with calico without running in ebpf dataplane")
 		}
 	}
 
@@ -236,19 +420,30 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		kubeProxyMode = cluster.Shoot.Spec.Kubernetes.KubeProxy.Mode
 	}
 
-	// Create shoot chart renderer
-	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(cluster.Shoot.Spec.Kubernetes.Version)
-	if err != nil {
-		return fmt.Errorf("could not create chart renderer for shoot '%s': %w", network.Namespace, err)
+	if !kubeProxyEnabled && isDataplaneMigration(migration) {
+		return false, nil, field.Forbidden(field.NewPath("spec", "kubernetes", "kubeProxy", "enabled"), "Disabling kube-proxy is forbidden while the dataplane is migrated, as nodes which do not run the eBPF dataplane yet depend on it")
 	}
 
-	var podCIDRs []string
-	if cluster.Shoot.Status.Networking != nil {
-		podCIDRs = cluster.Shoot.Status.Networking.Pods
+	if err := a.ensureVethMTU(network, networkConfig, cluster, kubeProxyEnabled, kubeProxyMode, ipFamilies, migration); err != nil {
+		return false, nil, err
 	}
 
-	calicoChart, err := chartspkg.RenderCalicoChart(
-		chartRenderer,
+	return kubeProxyEnabled, kubeProxyMode, nil
+}
+
+// renderCalicoChart computes the values of the calico chart for the given network and the network config derived for
+// it, and renders the chart with them.
+func (a *actuator) renderCalicoChart(
+	network *extensionsv1alpha1.Network,
+	networkConfig *calicov1alpha1.NetworkConfig,
+	cluster *extensionscontroller.Cluster,
+	kubeProxyEnabled bool,
+	kubeProxyMode *v1beta1.ProxyMode,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+	wireguardKeyRotation string,
+) (map[string]interface{}, []byte, error) {
+	values, err := chartspkg.ComputeCalicoChartValues(
 		network,
 		networkConfig,
 		cluster.Shoot.Spec.Kubernetes.Version,
@@ -257,28 +452,44 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, network *exte
 		kubeProxyMode,
 		features.FeatureGate.Enabled(features.NonPrivilegedCalicoNode),
 		cluster.Shoot.Spec.Networking.Nodes,
-		podCIDRs,
+		podCIDRs(cluster),
 		ipFamilies,
+		migration,
+		wireguardKeyRotation,
 	)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	data := map[string][]byte{chartspkg.CalicoConfigKey: calicoChart}
-	if err := managedresources.CreateForShoot(ctx, a.client, network.Namespace, CalicoConfigManagedResourceName, "extension-networking-calico", false, data); err != nil {
-		return err
+	// Create shoot chart renderer
+	chartRenderer, err := a.chartRendererFactory.NewChartRendererForShoot(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create chart renderer for shoot '%s': %w", network.Namespace, err)
 	}
 
-	if err := applyMonitoringConfig(ctx, a.client, a.chartApplier, network, false); err != nil {
-		return err
+	manifests, err := chartspkg.RenderCalicoChartWithValues(chartRenderer, values)
+	if err != nil {
+		return nil, nil, err
 	}
+	return values, manifests, nil
+}
 
-	return a.updateProviderStatus(ctx, network, networkConfig)
+// podCIDRs returns the pod CIDRs of the shoot, if they are already known.
+func podCIDRs(cluster *extensionscontroller.Cluster) []string {
+	if cluster.Shoot.Status.Networking == nil {
+		return nil
+	}
+	return cluster.Shoot.Status.Networking.Pods
 }
 
+// setPoolMode sets the given mode for the default pool of the shoot and the backend corresponding to it. Shoots with IPv6
+// only encapsulate the IPv6 pod traffic if the IPv6 pool mode is set explicitly, hence it is only overwritten to
+// disable the encapsulation.
 func setPoolMode(networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []extensionsv1alpha1.IPFamily, mode calicov1alpha1.PoolMode) {
 	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) {
-		networkConfig.IPv6.Mode = (*calicov1alpha1.PoolMode)(ptr.To(string(mode)))
+		if mode == calicov1alpha1.Never {
+			networkConfig.IPv6.Mode = (*calicov1alpha1.PoolMode)(ptr.To(string(mode)))
+		}
 	} else {
 		networkConfig.IPv4.Mode = (*calicov1alpha1.PoolMode)(ptr.To(string(mode)))
 	}
@@ -290,6 +501,14 @@ func setPoolMode(networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []exten
 	}
 }
 
+// encapsulatingIPv6PoolMode returns the mode of the IPv6 pool if it is configured to encapsulate the pod traffic.
+func encapsulatingIPv6PoolMode(networkConfig *calicov1alpha1.NetworkConfig) *calicov1alpha1.PoolMode {
+	if networkConfig.IPv6 == nil || networkConfig.IPv6.Mode == nil || *networkConfig.IPv6.Mode == calicov1alpha1.Never {
+		return nil
+	}
+	return networkConfig.IPv6.Mode
+}
+
 func setAutoDetectionMethod(networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []extensionsv1alpha1.IPFamily, autodetectionMode string) {
 	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) {
 		networkConfig.IPv4.AutoDetectionMethod = &autodetectionMode
@@ -302,6 +521,24 @@ func setAutoDetectionMethodV6(networkConfig *calicov1alpha1.NetworkConfig, ipFam
 	}
 }
 
+// setServiceClusterIPCIDRs defaults the advertised service cluster IP CIDRs to the service range of the shoot.
+func setServiceClusterIPCIDRs(networkConfig *calicov1alpha1.NetworkConfig, cluster *extensionscontroller.Cluster) {
+	if networkConfig.BGP == nil || networkConfig.BGP.ServiceClusterIPs == nil || len(networkConfig.BGP.ServiceClusterIPs.CIDRs) > 0 {
+		return
+	}
+
+	var serviceCIDRs []string
+	if cluster.Shoot.Status.Networking != nil && len(cluster.Shoot.Status.Networking.Services) > 0 {
+		serviceCIDRs = cluster.Shoot.Status.Networking.Services
+	} else if cluster.Shoot.Spec.Networking != nil && cluster.Shoot.Spec.Networking.Services != nil {
+		serviceCIDRs = []string{*cluster.Shoot.Spec.Networking.Services}
+	}
+
+	for _, serviceCIDR := range serviceCIDRs {
+		networkConfig.BGP.ServiceClusterIPs.CIDRs = append(networkConfig.BGP.ServiceClusterIPs.CIDRs, calicov1alpha1.CIDR(serviceCIDR))
+	}
+}
+
 func segregateNodeCIDRs(nodeCIDRs []string) ([]string, []string, error) {
 	var ipv4Nodes, ipv6Nodes []string
 	for _, nodeCidr := range nodeCIDRs {
@@ -392,37 +629,368 @@ func hasRouteCreated(node corev1.Node) bool {
 
 // isOverlaySwitch determines if there is a switch from overlay to non-overlay networking based on the desired state in the Network resource and the actual state in the calico-node DaemonSet
 func isOverlaySwitch(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network) (bool, error) {
-	desiredOverlayEnabled := true
+	desiredOverlayEnabled, err := isOverlayDesired(network)
+	if err != nil {
+		return false, err
+	}
 
-	if network.Spec.ProviderConfig != nil && network.Spec.ProviderConfig.Raw != nil {
-		networkConfig, err := calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
+	// Get the calico-node DaemonSet from the ManagedResource
+	calicoDaemonSet, err := getDaemonSetFromManagedResource(ctx, seedClient, network.Namespace, CalicoConfigManagedResourceName, calico.CalicoNodeDaemonSetName)
+	if err != nil {
+		// If shoot wants overlay enabled or first reconciliation of a new cluster happens, no switch
+		log.Info("Cannot read current overlay state during first reconciliation or with overlay enabled", "error", err)
+		return false, nil
+	}
+
+	actualOverlayEnabled := areTunnelsEnabled(calicoDaemonSet.Spec.Template.Spec.Containers)
+
+	return (desiredOverlayEnabled != actualOverlayEnabled) && !desiredOverlayEnabled, nil
+}
+
+// getOverlayEnablementPhase determines the phase of the switch from non-overlay to overlay networking based on the
+// desired state in the Network resource and the actual state in the calico-node DaemonSet. It returns an empty phase if
+// no switch is ongoing.
+// The switch is prepared as long as the default pools of the calico-node DaemonSet do not encapsulate the pod traffic,
+// i.e. the default IPv4 pool uses neither ipip nor vxlan and the default IPv6 pool does not use vxlan, and it continues
+// until calico has assigned the tunnel addresses to all nodes afterwards.
+func getOverlayEnablementPhase(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network, networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []extensionsv1alpha1.IPFamily) (calicov1alpha1.MigrationPhase, error) {
+	desiredIPv4Encapsulation := false
+	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) {
+		desiredOverlayEnabled, err := isOverlayDesired(network)
 		if err != nil {
-			return false, fmt.Errorf("failed to decode network provider config: %w", err)
+			return "", err
 		}
+		desiredIPv4Encapsulation = desiredOverlayEnabled
+	}
+	// The IPv6 pod traffic is only encapsulated if the mode of the IPv6 pool is set explicitly.
+	desiredIPv6Encapsulation := slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) && encapsulatingIPv6PoolMode(networkConfig) != nil
+	if !desiredIPv4Encapsulation && !desiredIPv6Encapsulation {
+		return "", nil
+	}
 
-		if networkConfig.Overlay != nil {
-			desiredOverlayEnabled = networkConfig.Overlay.Enabled
+	migration, err := getMigrationFromStatus(network)
+	if err != nil {
+		return "", err
+	}
+	if migration != nil && migration.Type == calicov1alpha1.MigrationTypeOverlayEnablement {
+		return migration.Phase, nil
+	}
+
+	containers := getDeployedCalicoNodeContainers(ctx, log, seedClient, network)
+	if containers == nil {
+		return "", nil
+	}
+
+	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) {
+		// The overlay is already enabled if the pod traffic of the IPv4 pool is encapsulated.
+		if poolMode, ok := ipv4PoolMode(containers); !ok || poolMode != calicov1alpha1.Never {
+			return "", nil
 		}
+		if desiredIPv4Encapsulation {
+			return calicov1alpha1.MigrationPhasePreparing, nil
+		}
+	}
+	if desiredIPv6Encapsulation && !isIPv6VXLANEnabled(containers) {
+		return calicov1alpha1.MigrationPhasePreparing, nil
 	}
+	return "", nil
+}
 
-	// Get the calico-node DaemonSet from the ManagedResource
-	calicoDaemonSet, err := getDaemonSetFromManagedResource(ctx, seedClient, network.Namespace, CalicoConfigManagedResourceName, "calico-node")
+// isOverlayDesired returns whether the overlay network is enabled in the provider config of the Network resource.
+func isOverlayDesired(network *extensionsv1alpha1.Network) (bool, error) {
+	if network.Spec.ProviderConfig == nil || network.Spec.ProviderConfig.Raw == nil {
+		return true, nil
+	}
+
+	networkConfig, err := calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
 	if err != nil {
-		// If shoot wants overlay enabled or first reconciliation of a new cluster happens, no switch
-		log.Info("Cannot read current overlay state during first reconciliation or with overlay enabled", "error", err)
+		return false, fmt.Errorf("failed to decode network provider config: %w", err)
+	}
+	if networkConfig.Overlay != nil {
+		return networkConfig.Overlay.Enabled, nil
+	}
+	return true, nil
+}
+
+// areTunnelsEnabled returns whether felix sets up the ipip or vxlan tunnel interfaces of any IP family in the given
+// calico-node containers.
+func areTunnelsEnabled(containers []corev1.Container) bool {
+	return envValue(containers, "FELIX_IPINIPENABLED") == "true" || isVXLANEnabled(containers) || isIPv6VXLANEnabled(containers)
+}
+
+// ipv4PoolMode returns the encapsulation mode of the default IPv4 pool in the given calico-node containers. The mode is
+// Never if neither ipip nor vxlan encapsulation is used. It returns false if the calico-node containers do not configure
+// an IPv4 pool.
+func ipv4PoolMode(containers []corev1.Container) (calicov1alpha1.PoolMode, bool) {
+	ipipMode, vxlanMode := envValue(containers, "CALICO_IPV4POOL_IPIP"), envValue(containers, "CALICO_IPV4POOL_VXLAN")
+	switch {
+	case isEncapsulating(ipipMode):
+		return calicov1alpha1.PoolMode(ipipMode), true
+	case isEncapsulating(vxlanMode):
+		return calicov1alpha1.PoolMode(vxlanMode), true
+	}
+	return calicov1alpha1.Never, ipipMode != "" || vxlanMode != ""
+}
+
+// isIPv6VXLANEnabled returns whether felix sets up the vxlan tunnel interfaces for IPv6 in the given calico-node
+// containers.
+func isIPv6VXLANEnabled(containers []corev1.Container) bool {
+	return envValue(containers, "FELIX_VXLANENABLEDV6") == "true"
+}
+
+// ensureNodesTunnelsBeforeOverlayEnablement drives the switch from non-overlay to overlay networking. While preparing,
+// calico-node is rolled out with the tunnel interfaces enabled, but the default pools keep the pod traffic
+// unencapsulated. Calico only assigns the tunnel addresses of a node for pools which encapsulate the pod traffic, hence
+// the tunnel interfaces of the nodes cannot be checked before. Once the calico-node pods on all nodes have been updated,
+// the encapsulation is enabled and the switch is completed as soon as calico has assigned the tunnel addresses to all
+// nodes.
+// It returns the progress of the migration if it is not completed yet.
+func (a *actuator) ensureNodesTunnelsBeforeOverlayEnablement(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, phase calicov1alpha1.MigrationPhase, ipFamilies []extensionsv1alpha1.IPFamily) (*calicov1alpha1.MigrationStatus, error) {
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: calicov1alpha1.MigrationTypeOverlayEnablement,
+		prepare:       calicoNodesCheck(areTunnelsEnabled, nil),
+		check: func(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+			annotations, err := getTunnelAddressAnnotations(ctx, shootClient, ipFamilies)
+			if err != nil {
+				return nil, err
+			}
+			return getNodesMigrationStatus(ctx, log, shootClient, areTunnelsEnabled, checkNodeTunnelAddresses(annotations))
+		},
+	}, phase)
+}
+
+// getTunnelAddressAnnotations returns the node annotations in which calico-node reports the tunnel addresses of the
+// encapsulation used by the default pools of the shoot cluster.
+func getTunnelAddressAnnotations(ctx context.Context, shootClient client.Client, ipFamilies []extensionsv1alpha1.IPFamily) ([]string, error) {
+	var annotations []string
+	for _, pool := range []struct {
+		name            string
+		ipFamily        extensionsv1alpha1.IPFamily
+		ipipAnnotation  string
+		vxlanAnnotation string
+	}{
+		{defaultIPv4PoolName, extensionsv1alpha1.IPFamilyIPv4, annotationCalicoIPv4IPIPTunnelAddress, annotationCalicoIPv4VXLANTunnelAddress},
+		{defaultIPv6PoolName, extensionsv1alpha1.IPFamilyIPv6, "", annotationCalicoIPv6VXLANTunnelAddress},
+	} {
+		if !slices.Contains(ipFamilies, pool.ipFamily) {
+			continue
+		}
+
+		ipPool := &unstructured.Unstructured{}
+		ipPool.SetGroupVersionKind(ipPoolGVK)
+		if err := shootClient.Get(ctx, client.ObjectKey{Name: pool.name}, ipPool); err != nil {
+			return nil, fmt.Errorf("failed to get IP pool %s: %w", pool.name, err)
+		}
+
+		ipipMode, _, _ := unstructured.NestedString(ipPool.Object, "spec", "ipipMode")
+		if pool.ipipAnnotation != "" && isEncapsulating(ipipMode) {
+			annotations = append(annotations, pool.ipipAnnotation)
+		}
+		vxlanMode, _, _ := unstructured.NestedString(ipPool.Object, "spec", "vxlanMode")
+		if isEncapsulating(vxlanMode) {
+			annotations = append(annotations, pool.vxlanAnnotation)
+		}
+	}
+	return annotations, nil
+}
+
+// isEncapsulating returns whether the given ipip or vxlan mode of an IP pool encapsulates the pod traffic.
+func isEncapsulating(mode string) bool {
+	return mode != "" && calicov1alpha1.PoolMode(mode) != calicov1alpha1.Never
+}
+
+// checkNodeTunnelAddresses returns a check whether calico has assigned the tunnel addresses reported in the given node
+// annotations. No node passes the check if no annotation is given, i.e. if the default pools do not encapsulate the pod
+// traffic yet.
+func checkNodeTunnelAddresses(annotations []string) func(corev1.Node) string {
+	return func(node corev1.Node) string {
+		if len(annotations) == 0 {
+			return "IP pools do not encapsulate the pod traffic yet"
+		}
+		for _, annotation := range annotations {
+			if node.Annotations[annotation] == "" {
+				return fmt.Sprintf("calico has not assigned a tunnel address to node (%s)", annotation)
+			}
+		}
+		return ""
+	}
+}
+
+// deferVXLAN keeps the IPIP encapsulation of the default IPv4 pool while the IPAM is migrated from host-local to
+// calico-ipam, as the VXLAN backend relies on the IPAM blocks of calico-ipam. The encapsulation is switched once the
+// IPAM migration is completed.
+func deferVXLAN(networkConfig *calicov1alpha1.NetworkConfig, migration *calicov1alpha1.MigrationStatus) {
+	if networkConfig == nil || networkConfig.VXLAN == nil || !networkConfig.VXLAN.Enabled ||
+		migration == nil || migration.Type != calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM {
+		return
+	}
+
+	networkConfig.VXLAN.Enabled = false
+	if networkConfig.IPv4 != nil {
+		networkConfig.IPv4.Pool = ptr.To(calicov1alpha1.PoolIPIP)
+	}
+	if networkConfig.Backend != nil && *networkConfig.Backend == calicov1alpha1.VXLan {
+		networkConfig.Backend = ptr.To(calicov1alpha1.Bird)
+	}
+	// VXLAN no longer implies calico-ipam, hence it is set explicitly.
+	if networkConfig.IPAM == nil {
+		networkConfig.IPAM = &calicov1alpha1.IPAM{}
+	}
+	networkConfig.IPAM.Type = calicov1alpha1.IPAMCalico
+}
+
+// isIPIPToVXLANMigration determines if the encapsulation of the default IPv4 pool is switched from IPIP to VXLAN based on
+// the desired state in the Network resource and the actual state in the calico-node DaemonSet.
+// The migration is ongoing as long as the default IPv4 pool of the calico-node DaemonSet uses IPIP encapsulation.
+func isIPIPToVXLANMigration(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network) (bool, error) {
+	desiredVXLANEnabled, err := isVXLANDesired(network)
+	if err != nil || !desiredVXLANEnabled {
+		return false, err
+	}
+
+	return isIPIPPoolEnabled(getDeployedCalicoNodeContainers(ctx, log, seedClient, network)), nil
+}
+
+// isVXLANDesired returns whether the overlay network with VXLAN encapsulation is enabled in the provider config of the
+// Network resource.
+func isVXLANDesired(network *extensionsv1alpha1.Network) (bool, error) {
+	if network.Spec.ProviderConfig == nil || network.Spec.ProviderConfig.Raw == nil {
 		return false, nil
 	}
 
-	actualOverlayEnabled := false
-	for _, container := range calicoDaemonSet.Spec.Template.Spec.Containers {
-		for _, env := range container.Env {
-			if env.Name == "FELIX_IPINIPENABLED" && env.Value == "true" || env.Name == "FELIX_VXLANENABLED" && env.Value == "true" {
-				actualOverlayEnabled = true
+	networkConfig, err := calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode network provider config: %w", err)
+	}
+	if networkConfig.IPv4 != nil && networkConfig.IPv4.Pool != nil && *networkConfig.IPv4.Pool != calicov1alpha1.PoolVXLan {
+		return false, nil
+	}
+	return networkConfig.Overlay != nil && networkConfig.Overlay.Enabled && networkConfig.VXLAN != nil && networkConfig.VXLAN.Enabled, nil
+}
+
+// isIPIPPoolEnabled returns whether the default IPv4 pool uses IPIP encapsulation in the given calico-node containers.
+func isIPIPPoolEnabled(containers []corev1.Container) bool {
+	return isEncapsulating(envValue(containers, "CALICO_IPV4POOL_IPIP"))
+}
+
+// isVXLANEnabled returns whether felix sets up the vxlan tunnel interfaces in the given calico-node containers.
+func isVXLANEnabled(containers []corev1.Container) bool {
+	return envValue(containers, "FELIX_VXLANENABLED") == "true"
+}
+
+// ensureNodesVXLANBeforeIPIPToVXLANMigration checks if the vxlan tunnel interfaces are ready on all nodes before
+// allowing the default IPv4 pool to be switched from IPIP to VXLAN encapsulation.
+// It returns the progress of the migration if the pod traffic must still be IPIP encapsulated.
+func (a *actuator) ensureNodesVXLANBeforeIPIPToVXLANMigration(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, vxlanMigration bool) (*calicov1alpha1.MigrationStatus, error) {
+	if !vxlanMigration {
+		return nil, nil
+	}
+
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: calicov1alpha1.MigrationTypeIPIPToVXLAN,
+		check:         calicoNodesCheck(isVXLANEnabled, nil),
+	}, calicov1alpha1.MigrationPhasePreparing)
+}
+
+// getNodesMigrationStatus checks for each node in the shoot cluster if the calico-node pod running on it is ready and
+// has been updated to the desired configuration, as determined by the given function. If set, the node itself is
+// checked by nodeCheck afterwards, which returns the reason why the node is not ready yet.
+func getNodesMigrationStatus(ctx context.Context, log logr.Logger, shootClient client.Client, updated func([]corev1.Container) bool, nodeCheck func(corev1.Node) string) ([]calicov1alpha1.NodeMigrationStatus, error) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	// The calico-node pods of worker pools with individual settings are labeled differently, see calico.CalicoNodeWorkerPoolAppLabel.
+	requirement, err := labels.NewRequirement("k8s-app", selection.In, []string{calico.CalicoNodeDaemonSetName, calico.CalicoNodeWorkerPoolAppLabel})
+	if err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	if err := shootClient.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*requirement)}); err != nil {
+		return nil, fmt.Errorf("failed to list calico-node pods: %w", err)
+	}
+
+	podsByNode := make(map[string]corev1.Pod, len(podList.Items))
+	for _, pod := range podList.Items {
+		podsByNode[pod.Spec.NodeName] = pod
+	}
+
+	nodes := make([]calicov1alpha1.NodeMigrationStatus, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		status := calicov1alpha1.NodeMigrationStatus{Name: node.Name}
+
+		pod, ok := podsByNode[node.Name]
+		switch {
+		case !ok:
+			status.Message = ptr.To("calico-node pod is not running")
+		case !updated(pod.Spec.Containers):
+			status.Message = ptr.To("calico-node pod has not been updated yet")
+		case !isPodReady(pod):
+			status.Message = ptr.To("calico-node pod is not ready")
+		default:
+			status.Ready = true
+			if nodeCheck != nil {
+				if reason := nodeCheck(node); reason != "" {
+					status.Ready, status.Message = false, &reason
+				}
 			}
 		}
+
+		if !status.Ready {
+			log.Info("Node has not been migrated yet", "nodeName", node.Name, "reason", *status.Message)
+		}
+		nodes = append(nodes, status)
 	}
 
-	return (desiredOverlayEnabled != actualOverlayEnabled) && !desiredOverlayEnabled, nil
+	return nodes, nil
+}
+
+// isKernelVersionAtLeast returns whether the kernel of the given node has at least the given major and minor version.
+// Nodes with an unknown kernel version are assumed to have it.
+func isKernelVersionAtLeast(node corev1.Node, minVersion *semver.Version) bool {
+	version, err := semver.NewVersion(node.Status.NodeInfo.KernelVersion)
+	if err != nil {
+		return true
+	}
+	return version.Major() > minVersion.Major() ||
+		(version.Major() == minVersion.Major() && version.Minor() >= minVersion.Minor())
+}
+
+// isPodReady checks if a pod has the Ready condition set to True
+func isPodReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// migrationPercentage returns the share of nodes which are ready for a migration in percent.
+func migrationPercentage(migration *calicov1alpha1.MigrationStatus) int32 {
+	if len(migration.Nodes) == 0 {
+		return 0
+	}
+
+	var ready int
+	for _, node := range migration.Nodes {
+		if node.Ready {
+			ready++
+		}
+	}
+	return int32(ready * 100 / len(migration.Nodes))
+}
+
+// migrationProgress summarizes the per-node progress of a migration.
+func migrationProgress(migration *calicov1alpha1.MigrationStatus) string {
+	var pending []string
+	for _, node := range migration.Nodes {
+		if !node.Ready {
+			pending = append(pending, node.Name)
+		}
+	}
+	return fmt.Sprintf("%d/%d nodes ready, pending: %s", len(migration.Nodes)-len(pending), len(migration.Nodes), strings.Join(pending, ", "))
 }
 
 // getDaemonSetFromManagedResource extracts a specific DaemonSet from a ManagedResource's secret
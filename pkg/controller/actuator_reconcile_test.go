@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
+)
+
+var _ = Describe("Reconcile", func() {
+	Describe("#desiredNetworkConfig", func() {
+		var (
+			a       *actuator
+			network *extensionsv1alpha1.Network
+			cluster *extensionscontroller.Cluster
+		)
+
+		BeforeEach(func() {
+			a = &actuator{}
+			network = &extensionsv1alpha1.Network{Spec: extensionsv1alpha1.NetworkSpec{
+				IPFamilies: []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6},
+			}}
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Type: "aws"}},
+				Status: gardencorev1beta1.ShootStatus{Constraints: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.ShootDualStackNodesMigrationReady, Status: gardencorev1beta1.ConditionFalse},
+				}},
+			}}
+		})
+
+		It("should keep IPv4 until the nodes are ready for dual-stack", func() {
+			_, networkConfig, ipFamilies, err := a.desiredNetworkConfig(network, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ipFamilies).To(Equal([]extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}))
+			Expect(networkConfig.IPv6).To(BeNil())
+		})
+
+		It("should leave the dual-stack migration to the seamless dual-stack migration", func() {
+			featuregatetesting.SetFeatureGateDuringTest(GinkgoT(), features.FeatureGate, features.SeamlessDualStackMigration, true)
+
+			_, networkConfig, ipFamilies, err := a.desiredNetworkConfig(network, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ipFamilies).To(Equal(network.Spec.IPFamilies))
+			Expect(networkConfig.IPv6).NotTo(BeNil())
+		})
+	})
+
+	Describe("#deferVXLAN", func() {
+		var networkConfig *calicov1alpha1.NetworkConfig
+
+		BeforeEach(func() {
+			networkConfig = &calicov1alpha1.NetworkConfig{
+				Backend: ptr.To(calicov1alpha1.VXLan),
+				IPAM:    &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMHostLocal},
+				IPv4:    &calicov1alpha1.IPv4{Pool: ptr.To(calicov1alpha1.PoolVXLan), Mode: ptr.To(calicov1alpha1.Always)},
+				Overlay: &calicov1alpha1.Overlay{Enabled: true},
+				VXLAN:   &calicov1alpha1.VXLAN{Enabled: true},
+			}
+		})
+
+		It("should keep the IPIP encapsulation while the IPAM is migrated", func() {
+			deferVXLAN(networkConfig, &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhaseMigrating})
+
+			Expect(networkConfig.VXLAN.Enabled).To(BeFalse())
+			Expect(networkConfig.IPv4.Pool).To(Equal(ptr.To(calicov1alpha1.PoolIPIP)))
+			Expect(networkConfig.Backend).To(Equal(ptr.To(calicov1alpha1.Bird)))
+			Expect(networkConfig.IPAM.Type).To(Equal(calicov1alpha1.IPAMCalico))
+		})
+
+		It("should switch to VXLAN without an IPAM migration", func() {
+			expected := networkConfig.DeepCopy()
+			deferVXLAN(networkConfig, &calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPIPToVXLAN, Phase: calicov1alpha1.MigrationPhasePreparing})
+			Expect(networkConfig).To(Equal(expected))
+
+			deferVXLAN(networkConfig, nil)
+			Expect(networkConfig).To(Equal(expected))
+		})
+	})
+
+	Describe("#deriveNetworkConfig", func() {
+		var (
+			a             *actuator
+			network       *extensionsv1alpha1.Network
+			networkConfig *calicov1alpha1.NetworkConfig
+			cluster       *extensionscontroller.Cluster
+		)
+
+		BeforeEach(func() {
+			a = &actuator{}
+			network = &extensionsv1alpha1.Network{}
+			networkConfig = &calicov1alpha1.NetworkConfig{EbpfDataplane: &calicov1alpha1.EbpfDataplane{Enabled: true}}
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeProxy: &gardencorev1beta1.KubeProxyConfig{Enabled: ptr.To(false)},
+				}},
+			}}
+		})
+
+		It("should forbid disabling kube-proxy while the dataplane is migrated", func() {
+			for _, migrationType := range []calicov1alpha1.MigrationType{calicov1alpha1.MigrationTypeIPTablesToEBPF, calicov1alpha1.MigrationTypeEBPFToIPTables} {
+				_, _, err := a.deriveNetworkConfig(network, networkConfig, cluster, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, &calicov1alpha1.MigrationStatus{Type: migrationType, Phase: calicov1alpha1.MigrationPhaseMigrating})
+				Expect(err).To(MatchError(ContainSubstring("Disabling kube-proxy is forbidden while the dataplane is migrated")))
+			}
+		})
+	})
+})
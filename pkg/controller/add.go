@@ -16,6 +16,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
 )
 
@@ -30,6 +32,14 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// UnderlayMTUs maps provider types to the MTU of the node network of the provider.
+	UnderlayMTUs map[string]int32
+	// Defaults maps provider types to the defaults of the network configs of the shoots of the provider.
+	Defaults map[string]calicov1alpha1.NetworkConfig
+	// Constraints maps provider types to the constraints of the network configs of the shoots of the provider.
+	Constraints map[string]config.NetworkConfigConstraints
+	// Profiles maps names to the network config profiles which shoots can reference.
+	Profiles map[string]config.NetworkConfigProfile
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
@@ -46,7 +56,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return network.Add(mgr, network.AddArgs{
-		Actuator:          NewActuator(mgr, chartApplier, extensioncontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot)),
+		Actuator:          NewActuator(mgr, chartApplier, extensioncontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot), opts.UnderlayMTUs, opts.Defaults, opts.Constraints, opts.Profiles),
 		ControllerOptions: opts.Controller,
 		Predicates:        network.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              calico.Type,
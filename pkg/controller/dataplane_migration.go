@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Masterminds/semver/v3"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+// kubeProxyLabels are the labels of the kube-proxy pods deployed by Gardener.
+var kubeProxyLabels = client.MatchingLabels{"app": "kubernetes", "role": "proxy"}
+
+// minBPFKernelVersion is the first Linux version which is supported by the eBPF dataplane of calico. Felix falls back
+// to the iptables dataplane on older kernels.
+var minBPFKernelVersion = semver.MustParse("5.3")
+
+// getDataplaneMigration determines the type and phase of a migration between the iptables and eBPF dataplanes. It
+// returns an empty type if no migration is ongoing.
+// A migration starts if the dataplane of the calico-node DaemonSet of the ManagedResource differs from the desired one,
+// and it continues until all calico-node pods have been updated afterwards.
+func getDataplaneMigration(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network, networkConfig *calicov1alpha1.NetworkConfig) (calicov1alpha1.MigrationType, calicov1alpha1.MigrationPhase, error) {
+	desiredMigrationType := calicov1alpha1.MigrationTypeEBPFToIPTables
+	if networkConfig.EbpfDataplane != nil && networkConfig.EbpfDataplane.Enabled {
+		desiredMigrationType = calicov1alpha1.MigrationTypeIPTablesToEBPF
+	}
+
+	migration, err := getMigrationFromStatus(network)
+	if err != nil {
+		return "", "", err
+	}
+	if migration != nil && migration.Type == desiredMigrationType && migration.Phase == calicov1alpha1.MigrationPhaseMigrating {
+		return migration.Type, migration.Phase, nil
+	}
+
+	containers := getDeployedCalicoNodeContainers(ctx, log, seedClient, network)
+	if containers == nil {
+		return "", "", nil
+	}
+
+	actualBPFEnabled := isBPFEnabled(containers)
+	switch {
+	case desiredMigrationType == calicov1alpha1.MigrationTypeIPTablesToEBPF && !actualBPFEnabled:
+		// eBPF is enabled alongside kube-proxy right away, kube-proxy takes over again on nodes which are not switched yet.
+		return calicov1alpha1.MigrationTypeIPTablesToEBPF, calicov1alpha1.MigrationPhaseMigrating, nil
+	case desiredMigrationType == calicov1alpha1.MigrationTypeEBPFToIPTables && actualBPFEnabled:
+		return calicov1alpha1.MigrationTypeEBPFToIPTables, calicov1alpha1.MigrationPhasePreparing, nil
+	}
+	return "", "", nil
+}
+
+// isBPFEnabled returns whether felix runs the eBPF dataplane in the given calico-node containers.
+func isBPFEnabled(containers []corev1.Container) bool {
+	return envValue(containers, "FELIX_BPFENABLED") == "true"
+}
+
+// ensureDataplaneMigration drives the migration between the iptables and eBPF dataplanes.
+// When switching to eBPF, the eBPF dataplane is rolled out alongside kube-proxy, and the migration completes once it is
+// active on all nodes, i.e. once kube-proxy may be removed. When switching back to iptables, the eBPF dataplane is kept
+// until kube-proxy is ready on all nodes, before it is disabled node by node. The nodes of worker pools which override
+// the dataplane are not affected by the migration.
+// It returns the progress of the migration if it is not completed yet.
+func (a *actuator) ensureDataplaneMigration(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, networkConfig *calicov1alpha1.NetworkConfig, migrationType calicov1alpha1.MigrationType, phase calicov1alpha1.MigrationPhase) (*calicov1alpha1.MigrationStatus, error) {
+	bpfEnabled := migrationType == calicov1alpha1.MigrationTypeIPTablesToEBPF
+	// calico-node only reports readiness once felix has programmed the dataplane, but felix falls back to iptables on
+	// nodes whose kernel does not support eBPF, hence these nodes are checked in addition.
+	var nodeCheck func(corev1.Node) string
+	if bpfEnabled {
+		nodeCheck = checkNodeBPF
+	}
+
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: migrationType,
+		prepare:       getNodesKubeProxyStatus,
+		check: func(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+			nodes, err := getNodesMigrationStatus(ctx, log, shootClient, func(containers []corev1.Container) bool { return isBPFEnabled(containers) == bpfEnabled }, nodeCheck)
+			if err != nil {
+				return nil, err
+			}
+			return withoutDataplaneOverrides(ctx, shootClient, nodes, networkConfig.WorkerPools)
+		},
+	}, phase)
+}
+
+// checkNodeBPF returns why felix cannot run the eBPF dataplane on the given node, or an empty string if it can.
+func checkNodeBPF(node corev1.Node) string {
+	if !isKernelVersionAtLeast(node, minBPFKernelVersion) {
+		return fmt.Sprintf("kernel %s does not support the eBPF dataplane", node.Status.NodeInfo.KernelVersion)
+	}
+	return ""
+}
+
+// isDataplaneMigration returns whether the given migration switches between the iptables and eBPF dataplanes.
+func isDataplaneMigration(migration *calicov1alpha1.MigrationStatus) bool {
+	return migration != nil && (migration.Type == calicov1alpha1.MigrationTypeIPTablesToEBPF || migration.Type == calicov1alpha1.MigrationTypeEBPFToIPTables)
+}
+
+// getNodesKubeProxyStatus checks for each node in the shoot cluster if the kube-proxy pod running on it is ready.
+func getNodesKubeProxyStatus(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	podList := &corev1.PodList{}
+	if err := shootClient.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), kubeProxyLabels); err != nil {
+		return nil, fmt.Errorf("failed to list kube-proxy pods: %w", err)
+	}
+
+	podsByNode := make(map[string]corev1.Pod, len(podList.Items))
+	for _, pod := range podList.Items {
+		podsByNode[pod.Spec.NodeName] = pod
+	}
+
+	nodes := make([]calicov1alpha1.NodeMigrationStatus, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		status := calicov1alpha1.NodeMigrationStatus{Name: node.Name}
+
+		pod, ok := podsByNode[node.Name]
+		switch {
+		case !ok:
+			status.Message = ptr.To("kube-proxy pod is not running")
+		case !isPodReady(pod):
+			status.Message = ptr.To("kube-proxy pod is not ready")
+		default:
+			status.Ready = true
+		}
+
+		if !status.Ready {
+			log.Info("Node does not have kube-proxy ready yet", "nodeName", node.Name, "reason", *status.Message)
+		}
+		nodes = append(nodes, status)
+	}
+
+	return nodes, nil
+}
+
+// withoutDataplaneOverrides removes the nodes of worker pools which override the dataplane from the given migration
+// status, as their calico-node DaemonSet keeps the dataplane of the worker pool.
+func withoutDataplaneOverrides(ctx context.Context, shootClient client.Client, nodes []calicov1alpha1.NodeMigrationStatus, workerPools []calicov1alpha1.WorkerPool) ([]calicov1alpha1.NodeMigrationStatus, error) {
+	var pools []string
+	for _, workerPool := range workerPools {
+		if workerPool.EbpfDataplane != nil {
+			pools = append(pools, workerPool.Name)
+		}
+	}
+	if len(pools) == 0 {
+		return nodes, nil
+	}
+
+	requirement, err := labels.NewRequirement(v1beta1constants.LabelWorkerPool, selection.In, pools)
+	if err != nil {
+		return nil, err
+	}
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*requirement)}); err != nil {
+		return nil, fmt.Errorf("failed to list nodes of worker pools with dataplane overrides: %w", err)
+	}
+
+	excluded := make(map[string]struct{}, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		excluded[node.Name] = struct{}{}
+	}
+	return slices.DeleteFunc(nodes, func(node calicov1alpha1.NodeMigrationStatus) bool {
+		_, ok := excluded[node.Name]
+		return ok
+	}), nil
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
+)
+
+// withEffectiveNetworkConfig returns the given Network resource with the network config profile it references and the
+// network config defaults of the operator for the provider of the shoot merged into its provider config, so that the
+// whole reconciliation works with the effective network config. The Network resource is returned as is if the operator
+// configured neither defaults for the provider nor profiles.
+func (a *actuator) withEffectiveNetworkConfig(network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster) (*extensionsv1alpha1.Network, error) {
+	var defaults *calicov1alpha1.NetworkConfig
+	if networkConfig, ok := a.defaults[cluster.Shoot.Spec.Provider.Type]; ok {
+		defaults = &networkConfig
+	}
+	if defaults == nil && len(a.profiles) == 0 {
+		return network, nil
+	}
+
+	providerConfig, err := calicov1alpha1helper.ResolveNetworkConfig(network.Spec.ProviderConfig, defaults, a.profiles, field.NewPath("spec", "providerConfig"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve network config: %w", err)
+	}
+
+	network = network.DeepCopy()
+	network.Spec.ProviderConfig = providerConfig
+	return network, nil
+}
+
+// profileStatus returns the status of the network config profile referenced by the given network config, if any.
+func (a *actuator) profileStatus(networkConfig *calicov1alpha1.NetworkConfig) *calicov1alpha1.ProfileStatus {
+	if networkConfig.Profile == nil {
+		return nil
+	}
+	return &calicov1alpha1.ProfileStatus{
+		Name:    *networkConfig.Profile,
+		Version: a.profiles[*networkConfig.Profile].Version,
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"net"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+const (
+	// annotationCalicoIPv6Address is the node annotation set by calico-node with the autodetected IPv6 address used
+	// for routing.
+	annotationCalicoIPv6Address = "projectcalico.org/IPv6Address"
+	// annotationCalicoIPv6VXLANTunnelAddress is the node annotation set by calico-node with the IPv6 address of the
+	// vxlan tunnel interface.
+	annotationCalicoIPv6VXLANTunnelAddress = "projectcalico.org/IPv6VXLANTunnelAddr"
+)
+
+// getDualStackMigrationPhase determines the phase of the migration from IPv4 to dual-stack. It returns an empty phase
+// if no migration is ongoing.
+// The migration is prepared as long as the calico-node DaemonSet of the ManagedResource does not support IPv6 while
+// dual-stack is desired.
+func getDualStackMigrationPhase(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network, ipFamilies []extensionsv1alpha1.IPFamily) (calicov1alpha1.MigrationPhase, error) {
+	if !slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) || !slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) {
+		return "", nil
+	}
+
+	migration, err := getMigrationFromStatus(network)
+	if err != nil {
+		return "", err
+	}
+	if migration != nil && migration.Type == calicov1alpha1.MigrationTypeIPv4ToDualStack {
+		return migration.Phase, nil
+	}
+
+	containers := getDeployedCalicoNodeContainers(ctx, log, seedClient, network)
+	if containers != nil && !isIPv6Enabled(containers) {
+		return calicov1alpha1.MigrationPhasePreparing, nil
+	}
+	return "", nil
+}
+
+// isIPv6Enabled returns whether felix supports IPv6 in the given calico-node containers.
+func isIPv6Enabled(containers []corev1.Container) bool {
+	return envValue(containers, "FELIX_IPV6SUPPORT") == "true"
+}
+
+// ensureDualStackMigration drives the migration from IPv4 to dual-stack. While preparing, the IPv6 pool is created and
+// calico-node is rolled out with IPv6 autodetection, but the CNI plugin keeps assigning IPv4 addresses only. Once every
+// node has an IPv6 address and an IPv6 pod CIDR and calico has set up IPv6 routing or tunneling for it, the IPAM ranges
+// of the CNI plugin are switched to dual-stack on all nodes at once.
+// It returns the progress of the migration if it is not completed yet.
+func (a *actuator) ensureDualStackMigration(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, phase calicov1alpha1.MigrationPhase) (*calicov1alpha1.MigrationStatus, error) {
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: calicov1alpha1.MigrationTypeIPv4ToDualStack,
+		check:         calicoNodesCheck(isIPv6Enabled, checkNodeIPv6),
+	}, phase)
+}
+
+// checkNodeIPv6 returns why the given node is not ready for dual-stack pods, or an empty string if it is.
+func checkNodeIPv6(node corev1.Node) string {
+	if !slices.ContainsFunc(node.Status.Addresses, func(address corev1.NodeAddress) bool {
+		ip := net.ParseIP(address.Address)
+		return ip != nil && ip.To4() == nil
+	}) {
+		return "node has no IPv6 address"
+	}
+	// host-local assigns the IPv6 addresses of the pods from the IPv6 pod CIDR of the node once the IPAM ranges are
+	// switched to dual-stack.
+	if !slices.ContainsFunc(node.Spec.PodCIDRs, func(podCIDR string) bool {
+		ip, _, err := net.ParseCIDR(podCIDR)
+		return err == nil && ip.To4() == nil
+	}) {
+		return "node has no IPv6 pod CIDR"
+	}
+	if node.Annotations[annotationCalicoIPv6Address] == "" && node.Annotations[annotationCalicoIPv6VXLANTunnelAddress] == "" {
+		return "calico has not set up IPv6 routing for node"
+	}
+	return ""
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/controller"
+)
+
+var (
+	defaultSyncPeriod = time.Second * 30
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{
+		DefaultAddArgs: healthcheck.DefaultAddArgs{
+			HealthCheckConfig: extensionsconfigv1alpha1.HealthCheckConfig{
+				SyncPeriod: metav1.Duration{Duration: defaultSyncPeriod},
+			},
+		},
+	}
+)
+
+// AddOptions are options to apply when adding the health checks of the Network resource to the manager.
+type AddOptions struct {
+	healthcheck.DefaultAddArgs
+	// Defaults maps provider types to the defaults of the network configs of the shoots of the provider.
+	Defaults map[string]calicov1alpha1.NetworkConfig
+	// Profiles maps names to the network config profiles which shoots can reference.
+	Profiles map[string]config.NetworkConfigProfile
+}
+
+// RegisterHealthChecks registers health checks for the Network resource.
+// All checks report on the SystemComponentsHealthy condition of the Network resource.
+func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	resolver := &networkConfigResolver{defaults: opts.Defaults, profiles: opts.Profiles}
+
+	return healthcheck.DefaultRegistration(
+		ctx,
+		calico.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.NetworkResource),
+		func() client.ObjectList { return &extensionsv1alpha1.NetworkList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Network{} },
+		mgr,
+		opts.DefaultAddArgs,
+		nil,
+		[]healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.CheckManagedResource(controller.CalicoConfigManagedResourceName),
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDaemonSetHealthChecker(calico.CalicoNodeDaemonSetName),
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   newWorkerPoolDaemonSetsHealthChecker(resolver),
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDeploymentHealthChecker(calico.CalicoTyphaDeploymentName),
+				PreCheckFunc:  resolver.typhaEnabled,
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDeploymentHealthChecker(calico.CalicoKubeControllersDeploymentName),
+				PreCheckFunc:  resolver.kubeControllersEnabled,
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDaemonSetHealthChecker(calico.MultusDaemonSetName),
+				PreCheckFunc:  resolver.multusEnabled,
+			},
+		},
+		sets.New[gardencorev1beta1.ConditionType](),
+	)
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return RegisterHealthChecks(ctx, mgr, DefaultAddOptions)
+}
+
+// networkConfigResolver resolves the network configs of Network resources with the network config profiles and the
+// defaults of the operator, like the actuator does.
+type networkConfigResolver struct {
+	defaults map[string]calicov1alpha1.NetworkConfig
+	profiles map[string]config.NetworkConfigProfile
+}
+
+// typhaEnabled returns true unless calico-typha is explicitly disabled in the network config.
+func (r *networkConfigResolver) typhaEnabled(_ context.Context, _ client.Client, obj client.Object, cluster *extensionscontroller.Cluster) bool {
+	config := r.networkConfig(obj, cluster)
+	return config == nil || config.Typha == nil || config.Typha.Enabled
+}
+
+// kubeControllersEnabled returns true unless the backend is set to none, in which case calico-kube-controllers
+// is not deployed.
+func (r *networkConfigResolver) kubeControllersEnabled(_ context.Context, _ client.Client, obj client.Object, cluster *extensionscontroller.Cluster) bool {
+	config := r.networkConfig(obj, cluster)
+	return config == nil || config.Backend == nil || *config.Backend != calicov1alpha1.None
+}
+
+// multusEnabled returns true if Multus CNI is enabled in the network config.
+func (r *networkConfigResolver) multusEnabled(_ context.Context, _ client.Client, obj client.Object, cluster *extensionscontroller.Cluster) bool {
+	config := r.networkConfig(obj, cluster)
+	return config != nil && config.Multus != nil && config.Multus.Enabled
+}
+
+// networkConfig returns the effective calico NetworkConfig of the given Network resource, i.e. its provider config with
+// the referenced profile and the defaults for the provider of the shoot merged into it. It returns nil if there is no
+// network config or it cannot be resolved, i.e. if the defaults of the chart apply.
+func (r *networkConfigResolver) networkConfig(obj client.Object, cluster *extensionscontroller.Cluster) *calicov1alpha1.NetworkConfig {
+	network, ok := obj.(*extensionsv1alpha1.Network)
+	if !ok {
+		return nil
+	}
+
+	var defaults *calicov1alpha1.NetworkConfig
+	if cluster != nil && cluster.Shoot != nil {
+		if networkConfig, ok := r.defaults[cluster.Shoot.Spec.Provider.Type]; ok {
+			defaults = &networkConfig
+		}
+	}
+
+	providerConfig, err := calicov1alpha1helper.ResolveNetworkConfig(network.Spec.ProviderConfig, defaults, r.profiles, field.NewPath("spec", "providerConfig"))
+	if err != nil || providerConfig == nil {
+		return nil
+	}
+
+	network = network.DeepCopy()
+	network.Spec.ProviderConfig = providerConfig
+	config, err := calicov1alpha1helper.CalicoNetworkConfigFromNetworkResource(network)
+	if err != nil {
+		return nil
+	}
+	return config
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+)
+
+var _ = Describe("Health checks", func() {
+	var (
+		ctx      = context.Background()
+		resolver *networkConfigResolver
+		network  *extensionsv1alpha1.Network
+		cluster  *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		resolver = &networkConfigResolver{}
+		network = &extensionsv1alpha1.Network{}
+		cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Type: "aws"}},
+		}}
+	})
+
+	It("should check the components deployed by default without a network config", func() {
+		Expect(resolver.typhaEnabled(ctx, nil, network, cluster)).To(BeTrue())
+		Expect(resolver.kubeControllersEnabled(ctx, nil, network, cluster)).To(BeTrue())
+		Expect(resolver.multusEnabled(ctx, nil, network, cluster)).To(BeFalse())
+	})
+
+	It("should consider the defaults of the operator", func() {
+		resolver.defaults = map[string]calicov1alpha1.NetworkConfig{
+			"aws": {Typha: &calicov1alpha1.Typha{Enabled: false}, Multus: &calicov1alpha1.Multus{Enabled: true}},
+		}
+
+		Expect(resolver.typhaEnabled(ctx, nil, network, cluster)).To(BeFalse())
+		Expect(resolver.multusEnabled(ctx, nil, network, cluster)).To(BeTrue())
+	})
+
+	It("should consider the network config profile referenced by the shoot", func() {
+		resolver.profiles = map[string]config.NetworkConfigProfile{
+			"no-bgp": {NetworkConfig: calicov1alpha1.NetworkConfig{Backend: ptr.To(calicov1alpha1.None)}},
+		}
+		network.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","profile":"no-bgp"}`)}
+
+		Expect(resolver.kubeControllersEnabled(ctx, nil, network, cluster)).To(BeFalse())
+	})
+})
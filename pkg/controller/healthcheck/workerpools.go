@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+// workerPoolDaemonSetsHealthChecker checks the calico-node DaemonSets of the worker pools with individual settings.
+// The DaemonSets depend on the network config of the shoot, hence they are determined for every check.
+type workerPoolDaemonSetsHealthChecker struct {
+	resolver    *networkConfigResolver
+	seedClient  client.Client
+	shootClient client.Client
+	provider    string
+	extension   string
+}
+
+var (
+	_ healthcheck.HealthCheck = &workerPoolDaemonSetsHealthChecker{}
+	_ healthcheck.SeedClient  = &workerPoolDaemonSetsHealthChecker{}
+	_ healthcheck.ShootClient = &workerPoolDaemonSetsHealthChecker{}
+)
+
+// newWorkerPoolDaemonSetsHealthChecker returns a health checker for the calico-node DaemonSets of the worker pools.
+func newWorkerPoolDaemonSetsHealthChecker(resolver *networkConfigResolver) *workerPoolDaemonSetsHealthChecker {
+	return &workerPoolDaemonSetsHealthChecker{resolver: resolver}
+}
+
+// InjectSeedClient injects the seed client.
+func (h *workerPoolDaemonSetsHealthChecker) InjectSeedClient(seedClient client.Client) {
+	h.seedClient = seedClient
+}
+
+// InjectShootClient injects the shoot client.
+func (h *workerPoolDaemonSetsHealthChecker) InjectShootClient(shootClient client.Client) {
+	h.shootClient = shootClient
+}
+
+// SetLoggerSuffix sets the provider and extension which are passed on to the DaemonSet health checkers.
+func (h *workerPoolDaemonSetsHealthChecker) SetLoggerSuffix(provider, extension string) {
+	h.provider, h.extension = provider, extension
+}
+
+// DeepCopy clones the health checker.
+func (h *workerPoolDaemonSetsHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	clone := *h
+	return &clone
+}
+
+// Check checks the calico-node DaemonSets of the worker pools configured in the network config of the given Network.
+func (h *workerPoolDaemonSetsHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	network := &extensionsv1alpha1.Network{}
+	if err := h.seedClient.Get(ctx, request, network); err != nil {
+		return nil, fmt.Errorf("failed to get network %q: %w", request.String(), err)
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, h.seedClient, request.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster %q: %w", request.Namespace, err)
+	}
+
+	config := h.resolver.networkConfig(network, cluster)
+	if config == nil {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	for _, workerPool := range config.WorkerPools {
+		checker := general.NewShootDaemonSetHealthChecker(calico.CalicoNodeDaemonSetName + "-" + workerPool.Name)
+		checker.InjectShootClient(h.shootClient)
+		checker.SetLoggerSuffix(h.provider, h.extension)
+
+		result, err := checker.Check(ctx, request)
+		if err != nil || result.Status != gardencorev1beta1.ConditionTrue {
+			return result, err
+		}
+	}
+
+	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Worker pool DaemonSets", func() {
+	var (
+		ctx         = context.Background()
+		request     = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "calico"}
+		seedClient  client.Client
+		shootClient client.Client
+		checker     *workerPoolDaemonSetsHealthChecker
+	)
+
+	createNetwork := func(providerConfig string) {
+		network := &extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: request.Namespace, Name: request.Name}}
+		if providerConfig != "" {
+			network.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(providerConfig)}
+		}
+		Expect(seedClient.Create(ctx, network)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+		checker = newWorkerPoolDaemonSetsHealthChecker(&networkConfigResolver{})
+		checker.InjectSeedClient(seedClient)
+		checker.InjectShootClient(shootClient)
+	})
+
+	It("should succeed without worker pools", func() {
+		createNetwork("")
+
+		Expect(checker.Check(ctx, request)).To(HaveField("Status", gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should check the calico-node DaemonSet of every worker pool", func() {
+		createNetwork(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","workerPools":[{"name":"foo"},{"name":"bar"}]}`)
+		Expect(shootClient.Create(ctx, &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "calico-node-foo"}})).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("calico-node-bar"))
+
+		Expect(shootClient.Create(ctx, &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "calico-node-bar"}})).To(Succeed())
+
+		Expect(checker.Check(ctx, request)).To(HaveField("Status", gardencorev1beta1.ConditionTrue))
+	})
+})
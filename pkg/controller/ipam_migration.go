@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	calicov1alpha1helper "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1/helper"
+)
+
+const (
+	// defaultIPv4PoolName is the name of the default IPv4 pool of calico.
+	defaultIPv4PoolName = "default-ipv4-ippool"
+	// defaultIPv6PoolName is the name of the default IPv6 pool of calico.
+	defaultIPv6PoolName = "default-ipv6-ippool"
+	// defaultIPv4BlockSize is the block size calico uses for the default IPv4 pool if none is configured.
+	defaultIPv4BlockSize = 26
+)
+
+var (
+	ipPoolGVK        = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "IPPool"}
+	ipamBlockGVK     = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "IPAMBlock"}
+	blockAffinityGVK = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "BlockAffinity"}
+)
+
+// getIPAMMigrationPhase determines the phase of the migration of the IPAM from host-local to calico-ipam. It returns
+// an empty phase if no migration is ongoing.
+// The migration is prepared as long as the calico-node DaemonSet of the ManagedResource uses host-local IPAM while
+// calico-ipam is desired, and it continues until all calico-node pods have been updated afterwards.
+func getIPAMMigrationPhase(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network, networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []extensionsv1alpha1.IPFamily) (calicov1alpha1.MigrationPhase, error) {
+	// Only IPv4 single-stack shoots are migrated, as the pod CIDR of the nodes is only reused for the IPv4 pool.
+	if !slices.Equal(ipFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}) {
+		return "", nil
+	}
+	var ipamType string
+	if networkConfig.IPAM != nil {
+		ipamType = networkConfig.IPAM.Type
+	}
+	if !calicov1alpha1helper.UsesCalicoIPAM(ipamType, networkConfig.VXLAN != nil && networkConfig.VXLAN.Enabled, true) {
+		return "", nil
+	}
+
+	migration, err := getMigrationFromStatus(network)
+	if err != nil {
+		return "", err
+	}
+	if migration != nil && migration.Type == calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM && migration.Phase == calicov1alpha1.MigrationPhaseMigrating {
+		return calicov1alpha1.MigrationPhaseMigrating, nil
+	}
+
+	if usesHostLocalIPAM(getDeployedCalicoNodeContainers(ctx, log, seedClient, network)) {
+		return calicov1alpha1.MigrationPhasePreparing, nil
+	}
+	return "", nil
+}
+
+// keepCalicoIPAM keeps calico-ipam for IPv4 shoots which enable vxlan with host-local set explicitly, if their
+// calico-node pods already use calico-ipam. Previous versions of the extension used calico-ipam with vxlan regardless of
+// the configured IPAM type, and calico-ipam cannot be switched back to host-local.
+func keepCalicoIPAM(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network, networkConfig *calicov1alpha1.NetworkConfig, ipFamilies []extensionsv1alpha1.IPFamily) {
+	if !slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) || networkConfig.VXLAN == nil || !networkConfig.VXLAN.Enabled ||
+		networkConfig.IPAM == nil || networkConfig.IPAM.Type != calicov1alpha1.IPAMHostLocal {
+		return
+	}
+
+	containers := getDeployedCalicoNodeContainers(ctx, log, seedClient, network)
+	if containers != nil && !usesHostLocalIPAM(containers) {
+		log.Info("Keeping calico-ipam used with vxlan although host-local is configured")
+		networkConfig.IPAM.Type = calicov1alpha1.IPAMCalico
+	}
+}
+
+// usesHostLocalIPAM returns whether the given calico-node containers are configured for host-local IPAM.
+func usesHostLocalIPAM(containers []corev1.Container) bool {
+	return envValue(containers, "USE_POD_CIDR") == "true"
+}
+
+// ensureIPAMMigration drives the migration of the IPAM from host-local to calico-ipam. While preparing, it creates
+// IPAM blocks affine to each node which cover the pod CIDR of the node, so that pods keep their addresses and calico-ipam
+// does not hand out addresses of other nodes. Afterwards, calico-ipam is rolled out and the calico-node pods are
+// recycled node by node, migrating the allocations of host-local on start.
+// It returns the progress of the migration if it is not completed yet.
+func (a *actuator) ensureIPAMMigration(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, phase calicov1alpha1.MigrationPhase) (*calicov1alpha1.MigrationStatus, error) {
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM,
+		prepare:       ensureNodesIPAMBlocks,
+		check:         calicoNodesCheck(func(containers []corev1.Container) bool { return !usesHostLocalIPAM(containers) }, nil),
+	}, phase)
+}
+
+// ensureNodesIPAMBlocks creates the IPAM blocks and block affinities which cover the pod CIDR of each node in the shoot
+// cluster. The blocks have the block size of the default IPv4 pool.
+func ensureNodesIPAMBlocks(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+	blockSize, err := getDefaultIPv4PoolBlockSize(ctx, shootClient)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	if err := checkNodesPodCIDRs(nodeList.Items, blockSize); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]calicov1alpha1.NodeMigrationStatus, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		status := calicov1alpha1.NodeMigrationStatus{Name: node.Name}
+		if message, err := ensureNodeIPAMBlocks(ctx, shootClient, node, blockSize); err != nil {
+			return nil, err
+		} else if message != "" {
+			status.Message = ptr.To(message)
+			log.Info("Node does not have IPAM blocks yet", "nodeName", node.Name, "reason", message)
+		} else {
+			status.Ready = true
+		}
+		nodes = append(nodes, status)
+	}
+
+	return nodes, nil
+}
+
+// checkNodesPodCIDRs returns an error if the pod CIDR of a node is smaller than the given block size. The addresses of
+// such a node cannot be covered by blocks affine to it, and the block size of the default IPv4 pool cannot be changed,
+// hence the IPAM of the shoot cannot be migrated at all.
+func checkNodesPodCIDRs(nodes []corev1.Node, blockSize int) error {
+	for _, node := range nodes {
+		_, ipNet, err := net.ParseCIDR(node.Spec.PodCIDR)
+		if err != nil {
+			// Nodes without a valid pod CIDR are reported in the migration status, they may still get one.
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > blockSize {
+			return gardencorev1beta1helper.NewErrorWithCodes(fmt.Errorf("cannot migrate to calico-ipam, the pod CIDR %s of node %s is smaller than the block size /%d of the default IPv4 pool", node.Spec.PodCIDR, node.Name, blockSize), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+	}
+	return nil
+}
+
+// ensureNodeIPAMBlocks creates the IPAM blocks and block affinities which cover the pod CIDR of the given node. It
+// returns a message if the blocks cannot be created for the node.
+func ensureNodeIPAMBlocks(ctx context.Context, shootClient client.Client, node corev1.Node, blockSize int) (string, error) {
+	if node.Spec.PodCIDR == "" {
+		return "node has no pod CIDR", nil
+	}
+
+	blocks, err := splitIntoBlocks(node.Spec.PodCIDR, blockSize)
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	affinity := "host:" + node.Name
+	for _, block := range blocks {
+		ipamBlock := newIPAMBlock(block, affinity)
+		if err := shootClient.Create(ctx, ipamBlock); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				return "", fmt.Errorf("failed to create IPAM block %s: %w", block, err)
+			}
+			if err := shootClient.Get(ctx, client.ObjectKeyFromObject(ipamBlock), ipamBlock); err != nil {
+				return "", fmt.Errorf("failed to get IPAM block %s: %w", block, err)
+			}
+			if existing, _, _ := unstructured.NestedString(ipamBlock.Object, "spec", "affinity"); existing != affinity {
+				return fmt.Sprintf("IPAM block %s is affine to %q", block, existing), nil
+			}
+		}
+
+		if err := shootClient.Create(ctx, newBlockAffinity(block, node.Name)); client.IgnoreAlreadyExists(err) != nil {
+			return "", fmt.Errorf("failed to create block affinity for %s: %w", block, err)
+		}
+	}
+
+	return "", nil
+}
+
+// getDefaultIPv4PoolBlockSize returns the block size of the default IPv4 pool in the shoot cluster.
+func getDefaultIPv4PoolBlockSize(ctx context.Context, shootClient client.Client) (int, error) {
+	pool := &unstructured.Unstructured{}
+	pool.SetGroupVersionKind(ipPoolGVK)
+	if err := shootClient.Get(ctx, client.ObjectKey{Name: defaultIPv4PoolName}, pool); err != nil {
+		return 0, fmt.Errorf("failed to get IP pool %s: %w", defaultIPv4PoolName, err)
+	}
+
+	blockSize, found, err := unstructured.NestedInt64(pool.Object, "spec", "blockSize")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read block size of IP pool %s: %w", defaultIPv4PoolName, err)
+	}
+	if !found {
+		return defaultIPv4BlockSize, nil
+	}
+	return int(blockSize), nil
+}
+
+// splitIntoBlocks splits the given IPv4 CIDR into CIDRs of the given block size.
+func splitIntoBlocks(cidr string, blockSize int) ([]string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ipNet.IP.To4() == nil {
+		return nil, fmt.Errorf("pod CIDR %q is no IPv4 CIDR", cidr)
+	}
+
+	ones, _ := ipNet.Mask.Size()
+	if ones > blockSize {
+		return nil, fmt.Errorf("pod CIDR %s is smaller than the block size /%d of the IP pool", cidr, blockSize)
+	}
+
+	var (
+		start  = binary.BigEndian.Uint32(ipNet.IP.To4())
+		count  = uint32(1) << (blockSize - ones)
+		step   = uint32(1) << (32 - blockSize)
+		blocks = make([]string, 0, count)
+	)
+	for i := range count {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, start+i*step)
+		blocks = append(blocks, fmt.Sprintf("%s/%d", ip, blockSize))
+	}
+	return blocks, nil
+}
+
+// newIPAMBlock returns an empty IPAM block for the given CIDR with the given affinity.
+func newIPAMBlock(cidr, affinity string) *unstructured.Unstructured {
+	_, ipNet, _ := net.ParseCIDR(cidr)
+	ones, bits := ipNet.Mask.Size()
+
+	size := 1 << (bits - ones)
+	allocations := make([]interface{}, size)
+	unallocated := make([]interface{}, size)
+	for i := range size {
+		unallocated[i] = int64(i)
+	}
+
+	block := &unstructured.Unstructured{}
+	block.SetGroupVersionKind(ipamBlockGVK)
+	block.SetName(cidrToName(cidr))
+	block.Object["spec"] = map[string]interface{}{
+		"cidr":           cidr,
+		"affinity":       affinity,
+		"strictAffinity": false,
+		"allocations":    allocations,
+		"unallocated":    unallocated,
+		"attributes":     []interface{}{},
+		"deleted":        false,
+	}
+	return block
+}
+
+// newBlockAffinity returns a confirmed affinity of the block with the given CIDR to the given node.
+func newBlockAffinity(cidr, nodeName string) *unstructured.Unstructured {
+	affinity := &unstructured.Unstructured{}
+	affinity.SetGroupVersionKind(blockAffinityGVK)
+	affinity.SetName(nodeName + "-" + cidrToName(cidr))
+	affinity.Object["spec"] = map[string]interface{}{
+		"cidr":    cidr,
+		"node":    nodeName,
+		"state":   "confirmed",
+		"deleted": "false",
+	}
+	return affinity
+}
+
+// cidrToName converts a CIDR to the name calico uses for IPAM objects, e.g. 10.0.0.0/26 to 10-0-0-0-26.
+func cidrToName(cidr string) string {
+	return strings.NewReplacer(".", "-", "/", "-").Replace(cidr)
+}
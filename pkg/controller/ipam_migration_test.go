@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("IPAM migration", func() {
+	Describe("#checkNodesPodCIDRs", func() {
+		node := func(name, podCIDR string) corev1.Node {
+			return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: corev1.NodeSpec{PodCIDR: podCIDR}}
+		}
+
+		It("should accept pod CIDRs which are at least as large as the block size", func() {
+			Expect(checkNodesPodCIDRs([]corev1.Node{node("a", "100.96.0.0/24"), node("b", "100.96.1.0/26"), node("c", "")}, 26)).To(Succeed())
+		})
+
+		It("should fail for a pod CIDR smaller than the block size", func() {
+			Expect(checkNodesPodCIDRs([]corev1.Node{node("a", "100.96.0.0/24"), node("b", "100.96.1.0/28")}, 26)).To(MatchError(ContainSubstring("the pod CIDR 100.96.1.0/28 of node b is smaller than the block size /26")))
+		})
+	})
+
+	Describe("#splitIntoBlocks", func() {
+		It("should split the pod CIDR into blocks", func() {
+			Expect(splitIntoBlocks("100.96.1.0/24", 26)).To(Equal([]string{"100.96.1.0/26", "100.96.1.64/26", "100.96.1.128/26", "100.96.1.192/26"}))
+		})
+	})
+})
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+// nodesCheck returns the progress of the nodes of the shoot cluster in a phase of a migration.
+type nodesCheck func(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error)
+
+// nodeMigration describes how the nodes of the shoot cluster are checked during a migration of the pod network.
+type nodeMigration struct {
+	// migrationType is the type of the migration reported in the provider status.
+	migrationType calicov1alpha1.MigrationType
+	// prepare checks the nodes in the Preparing phase of migrations which are completed in the Migrating phase. The
+	// migration continues in the Migrating phase once all nodes are prepared.
+	prepare nodesCheck
+	// check checks the nodes in the phase in which the migration is completed.
+	check nodesCheck
+}
+
+// calicoNodesCheck returns a check whether the calico-node pods of all nodes have been updated to the desired
+// configuration and are ready, see getNodesMigrationStatus.
+func calicoNodesCheck(updated func([]corev1.Container) bool, nodeCheck func(corev1.Node) string) nodesCheck {
+	return func(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+		return getNodesMigrationStatus(ctx, log, shootClient, updated, nodeCheck)
+	}
+}
+
+// ensureMigration drives the given migration in the given phase, see driveMigration. Nothing is migrated if the phase
+// is empty.
+func (a *actuator) ensureMigration(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, migration nodeMigration, phase calicov1alpha1.MigrationPhase) (*calicov1alpha1.MigrationStatus, error) {
+	if phase == "" {
+		return nil, nil
+	}
+
+	shootClient, err := a.getShootClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check the nodes for the %s migration: %w", migration.migrationType, err)
+	}
+	return driveMigration(ctx, log, shootClient, migration, phase)
+}
+
+// driveMigration checks the nodes of the shoot cluster for the given migration in the given phase. The migration is
+// completed once all nodes are ready in the phase in which it is checked, i.e. right away if the shoot has no nodes, as
+// nodes joining later get the migrated configuration. A migration which continues in the Migrating phase after its
+// preparation is completed in a later reconciliation, as the migrated configuration has not been rolled out before.
+// It returns the progress of the migration if it is not completed yet.
+func driveMigration(ctx context.Context, log logr.Logger, shootClient client.Client, migration nodeMigration, phase calicov1alpha1.MigrationPhase) (*calicov1alpha1.MigrationStatus, error) {
+	completable := true
+	if migration.prepare != nil && phase == calicov1alpha1.MigrationPhasePreparing {
+		nodes, err := migration.prepare(ctx, log, shootClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare the nodes for the %s migration: %w", migration.migrationType, err)
+		}
+
+		if !allNodesReady(nodes) {
+			return &calicov1alpha1.MigrationStatus{
+				Type:  migration.migrationType,
+				Phase: calicov1alpha1.MigrationPhasePreparing,
+				Nodes: nodes,
+			}, nil
+		}
+		log.Info("All nodes are prepared for the migration", "migration", migration.migrationType)
+		phase, completable = calicov1alpha1.MigrationPhaseMigrating, false
+	}
+
+	nodes, err := migration.check(ctx, log, shootClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the nodes for the %s migration: %w", migration.migrationType, err)
+	}
+
+	if completable && allNodesReady(nodes) {
+		log.Info("Migration has been completed on all nodes", "migration", migration.migrationType)
+		return nil, nil
+	}
+
+	return &calicov1alpha1.MigrationStatus{
+		Type:  migration.migrationType,
+		Phase: phase,
+		Nodes: nodes,
+	}, nil
+}
+
+// allNodesReady returns whether all of the given nodes are ready for a migration.
+func allNodesReady(nodes []calicov1alpha1.NodeMigrationStatus) bool {
+	return !slices.ContainsFunc(nodes, func(node calicov1alpha1.NodeMigrationStatus) bool { return !node.Ready })
+}
+
+// getDeployedCalicoNodeContainers returns the containers of the calico-node DaemonSet in the ManagedResource of the
+// given network, which the migrations compare to the desired configuration. It returns nil if the DaemonSet cannot be
+// read, e.g. during the first reconciliation, in which case nothing needs to be migrated.
+func getDeployedCalicoNodeContainers(ctx context.Context, log logr.Logger, seedClient client.Client, network *extensionsv1alpha1.Network) []corev1.Container {
+	calicoDaemonSet, err := getDaemonSetFromManagedResource(ctx, seedClient, network.Namespace, CalicoConfigManagedResourceName, calico.CalicoNodeDaemonSetName)
+	if err != nil {
+		log.Info("Cannot read the deployed calico-node DaemonSet, nothing is migrated", "error", err)
+		return nil
+	}
+	return calicoDaemonSet.Spec.Template.Spec.Containers
+}
+
+// envValue returns the value of the environment variable with the given name in the given calico-node containers. It
+// returns an empty string if the variable is not set.
+func envValue(containers []corev1.Container, name string) string {
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.Name == name {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
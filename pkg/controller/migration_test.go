@@ -0,0 +1,497 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/calico"
+)
+
+var _ = Describe("Migration", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		seedClient client.Client
+		network    *extensionsv1alpha1.Network
+
+		ipv4      = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+		dualStack = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+	)
+
+	// deployCalicoNode stores a calico-node DaemonSet with the given environment in the ManagedResource, as it was
+	// deployed by the previous reconciliation.
+	deployCalicoNode := func(env ...corev1.EnvVar) {
+		daemonSet, err := json.Marshal(&appsv1.DaemonSet{
+			TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "DaemonSet"},
+			ObjectMeta: metav1.ObjectMeta{Name: calico.CalicoNodeDaemonSetName, Namespace: metav1.NamespaceSystem},
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: calico.CalicoNodeDaemonSetName, Env: env}},
+			}}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(seedClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "managedresource-" + CalicoConfigManagedResourceName, Namespace: namespace},
+			Data:       map[string][]byte{"config.yaml": daemonSet},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: CalicoConfigManagedResourceName, Namespace: namespace},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				SecretRefs: []corev1.LocalObjectReference{{Name: "managedresource-" + CalicoConfigManagedResourceName}},
+			},
+		})).To(Succeed())
+	}
+
+	// reportMigration reports the given migration in the provider status of the Network, as it was reported by the
+	// previous reconciliation.
+	reportMigration := func(migrationType calicov1alpha1.MigrationType, phase calicov1alpha1.MigrationPhase) {
+		status, err := json.Marshal(&calicov1alpha1.NetworkStatus{
+			TypeMeta:  StatusTypeMeta,
+			Migration: &calicov1alpha1.MigrationStatus{Type: migrationType, Phase: phase},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		network.Status.ProviderStatus = &runtime.RawExtension{Raw: status}
+	}
+
+	env := func(name, value string) corev1.EnvVar {
+		return corev1.EnvVar{Name: name, Value: value}
+	}
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		network = &extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Name: "calico", Namespace: namespace}}
+	})
+
+	Describe("#getOverlayEnablementPhase", func() {
+		var (
+			networkConfig *calicov1alpha1.NetworkConfig
+			ipv6          = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv6}
+		)
+
+		BeforeEach(func() {
+			networkConfig = &calicov1alpha1.NetworkConfig{}
+		})
+
+		It("should not switch during the first reconciliation", func() {
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(BeEmpty())
+		})
+
+		It("should prepare the switch if the IPv4 pool does not encapsulate the pod traffic", func() {
+			deployCalicoNode(env("CALICO_IPV4POOL_IPIP", "Never"))
+
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+		})
+
+		It("should not switch if the IPv4 pool already encapsulates the pod traffic", func() {
+			deployCalicoNode(env("CALICO_IPV4POOL_IPIP", "Always"))
+
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(BeEmpty())
+		})
+
+		It("should continue the reported switch", func() {
+			deployCalicoNode(env("CALICO_IPV4POOL_IPIP", "Always"))
+			reportMigration(calicov1alpha1.MigrationTypeOverlayEnablement, calicov1alpha1.MigrationPhaseMigrating)
+
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(Equal(calicov1alpha1.MigrationPhaseMigrating))
+		})
+
+		It("should prepare the switch of IPv6 single-stack shoots if the IPv6 pool is configured to encapsulate the pod traffic", func() {
+			deployCalicoNode(env("FELIX_IPV6SUPPORT", "true"))
+
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv6)).To(BeEmpty())
+			networkConfig.IPv6 = &calicov1alpha1.IPv6{Mode: ptr.To(calicov1alpha1.Always)}
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv6)).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+		})
+
+		It("should not switch if the IPv6 pool already encapsulates the pod traffic", func() {
+			networkConfig.IPv6 = &calicov1alpha1.IPv6{Mode: ptr.To(calicov1alpha1.Always)}
+			deployCalicoNode(env("FELIX_VXLANENABLEDV6", "true"))
+
+			Expect(getOverlayEnablementPhase(ctx, log, seedClient, network, networkConfig, ipv6)).To(BeEmpty())
+		})
+	})
+
+	Describe("#getTunnelAddressAnnotations", func() {
+		ipPool := func(name, ipipMode, vxlanMode string) *unstructured.Unstructured {
+			pool := &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": name},
+				"spec":     map[string]interface{}{"ipipMode": ipipMode, "vxlanMode": vxlanMode},
+			}}
+			pool.SetGroupVersionKind(ipPoolGVK)
+			return pool
+		}
+
+		It("should return the annotations of the encapsulation of the default pools", func() {
+			shootClient := fakeclient.NewClientBuilder().WithObjects(
+				ipPool(defaultIPv4PoolName, "Always", "Never"),
+				ipPool(defaultIPv6PoolName, "Never", "CrossSubnet"),
+			).Build()
+
+			Expect(getTunnelAddressAnnotations(ctx, shootClient, dualStack)).To(Equal([]string{annotationCalicoIPv4IPIPTunnelAddress, annotationCalicoIPv6VXLANTunnelAddress}))
+			Expect(getTunnelAddressAnnotations(ctx, shootClient, ipv4)).To(Equal([]string{annotationCalicoIPv4IPIPTunnelAddress}))
+		})
+
+		It("should not return annotations for pools which do not encapsulate the pod traffic", func() {
+			shootClient := fakeclient.NewClientBuilder().WithObjects(ipPool(defaultIPv4PoolName, "Never", "Never")).Build()
+
+			Expect(getTunnelAddressAnnotations(ctx, shootClient, ipv4)).To(BeEmpty())
+		})
+	})
+
+	Describe("#checkNodeTunnelAddresses", func() {
+		It("should require the tunnel addresses of all given annotations", func() {
+			check := checkNodeTunnelAddresses([]string{annotationCalicoIPv4VXLANTunnelAddress, annotationCalicoIPv6VXLANTunnelAddress})
+			node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "a", Annotations: map[string]string{annotationCalicoIPv4VXLANTunnelAddress: "100.96.0.1"}}}
+
+			Expect(check(node)).To(Equal("calico has not assigned a tunnel address to node (projectcalico.org/IPv6VXLANTunnelAddr)"))
+			node.Annotations[annotationCalicoIPv6VXLANTunnelAddress] = "2001:db8::1"
+			Expect(check(node)).To(BeEmpty())
+		})
+
+		It("should not accept nodes as long as the pools do not encapsulate the pod traffic", func() {
+			Expect(checkNodeTunnelAddresses(nil)(corev1.Node{})).To(Equal("IP pools do not encapsulate the pod traffic yet"))
+		})
+	})
+
+	Describe("#getIPAMMigrationPhase", func() {
+		var networkConfig *calicov1alpha1.NetworkConfig
+
+		BeforeEach(func() {
+			networkConfig = &calicov1alpha1.NetworkConfig{IPAM: &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMCalico}}
+		})
+
+		It("should not migrate during the first reconciliation", func() {
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(BeEmpty())
+		})
+
+		It("should prepare the migration if calico-node still uses host-local", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "true"))
+
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+		})
+
+		It("should continue a migration which is in the migrating phase", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "false"))
+			reportMigration(calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, calicov1alpha1.MigrationPhaseMigrating)
+
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(Equal(calicov1alpha1.MigrationPhaseMigrating))
+		})
+
+		It("should complete the migration once calico-node uses calico-ipam", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "false"))
+			reportMigration(calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, calicov1alpha1.MigrationPhasePreparing)
+
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(BeEmpty())
+		})
+
+		It("should not migrate dual-stack shoots or host-local", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "true"))
+
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, dualStack)).To(BeEmpty())
+
+			networkConfig.IPAM.Type = calicov1alpha1.IPAMHostLocal
+			Expect(getIPAMMigrationPhase(ctx, log, seedClient, network, networkConfig, ipv4)).To(BeEmpty())
+		})
+	})
+
+	Describe("#keepCalicoIPAM", func() {
+		var networkConfig *calicov1alpha1.NetworkConfig
+
+		BeforeEach(func() {
+			networkConfig = &calicov1alpha1.NetworkConfig{
+				IPAM:  &calicov1alpha1.IPAM{Type: calicov1alpha1.IPAMHostLocal},
+				VXLAN: &calicov1alpha1.VXLAN{Enabled: true},
+			}
+		})
+
+		It("should keep calico-ipam if calico-node already uses it", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "false"))
+
+			keepCalicoIPAM(ctx, log, seedClient, network, networkConfig, ipv4)
+			Expect(networkConfig.IPAM.Type).To(Equal(calicov1alpha1.IPAMCalico))
+		})
+
+		It("should keep host-local if calico-node uses it", func() {
+			deployCalicoNode(env("USE_POD_CIDR", "true"))
+
+			keepCalicoIPAM(ctx, log, seedClient, network, networkConfig, ipv4)
+			Expect(networkConfig.IPAM.Type).To(Equal(calicov1alpha1.IPAMHostLocal))
+		})
+
+		It("should keep host-local during the first reconciliation or without vxlan", func() {
+			keepCalicoIPAM(ctx, log, seedClient, network, networkConfig, ipv4)
+			Expect(networkConfig.IPAM.Type).To(Equal(calicov1alpha1.IPAMHostLocal))
+
+			deployCalicoNode(env("USE_POD_CIDR", "false"))
+			networkConfig.VXLAN.Enabled = false
+
+			keepCalicoIPAM(ctx, log, seedClient, network, networkConfig, ipv4)
+			Expect(networkConfig.IPAM.Type).To(Equal(calicov1alpha1.IPAMHostLocal))
+		})
+	})
+
+	Describe("#getDataplaneMigration", func() {
+		var networkConfig *calicov1alpha1.NetworkConfig
+
+		BeforeEach(func() {
+			networkConfig = &calicov1alpha1.NetworkConfig{EbpfDataplane: &calicov1alpha1.EbpfDataplane{Enabled: true}}
+		})
+
+		It("should not migrate during the first reconciliation", func() {
+			Expect(getDataplaneMigration(ctx, log, seedClient, network, networkConfig)).To(BeEmpty())
+		})
+
+		It("should migrate to eBPF right away", func() {
+			deployCalicoNode(env("FELIX_BPFENABLED", "false"))
+
+			migrationType, phase, err := getDataplaneMigration(ctx, log, seedClient, network, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrationType).To(Equal(calicov1alpha1.MigrationTypeIPTablesToEBPF))
+			Expect(phase).To(Equal(calicov1alpha1.MigrationPhaseMigrating))
+		})
+
+		It("should continue the migration to eBPF until it is reported as completed", func() {
+			deployCalicoNode(env("FELIX_BPFENABLED", "true"))
+			reportMigration(calicov1alpha1.MigrationTypeIPTablesToEBPF, calicov1alpha1.MigrationPhaseMigrating)
+
+			migrationType, phase, err := getDataplaneMigration(ctx, log, seedClient, network, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrationType).To(Equal(calicov1alpha1.MigrationTypeIPTablesToEBPF))
+			Expect(phase).To(Equal(calicov1alpha1.MigrationPhaseMigrating))
+		})
+
+		It("should prepare the migration to iptables", func() {
+			deployCalicoNode(env("FELIX_BPFENABLED", "true"))
+			networkConfig.EbpfDataplane.Enabled = false
+
+			migrationType, phase, err := getDataplaneMigration(ctx, log, seedClient, network, networkConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migrationType).To(Equal(calicov1alpha1.MigrationTypeEBPFToIPTables))
+			Expect(phase).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+		})
+
+		It("should not migrate if the dataplane is unchanged", func() {
+			deployCalicoNode(env("FELIX_BPFENABLED", "true"))
+
+			Expect(getDataplaneMigration(ctx, log, seedClient, network, networkConfig)).To(BeEmpty())
+		})
+	})
+
+	Describe("#getDualStackMigrationPhase", func() {
+		It("should not migrate during the first reconciliation", func() {
+			Expect(getDualStackMigrationPhase(ctx, log, seedClient, network, dualStack)).To(BeEmpty())
+		})
+
+		It("should prepare the migration if calico-node does not support IPv6", func() {
+			deployCalicoNode(env("FELIX_IPV6SUPPORT", "false"))
+
+			Expect(getDualStackMigrationPhase(ctx, log, seedClient, network, dualStack)).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+			Expect(getDualStackMigrationPhase(ctx, log, seedClient, network, ipv4)).To(BeEmpty())
+		})
+
+		It("should continue the reported migration", func() {
+			deployCalicoNode(env("FELIX_IPV6SUPPORT", "true"))
+			reportMigration(calicov1alpha1.MigrationTypeIPv4ToDualStack, calicov1alpha1.MigrationPhasePreparing)
+
+			Expect(getDualStackMigrationPhase(ctx, log, seedClient, network, dualStack)).To(Equal(calicov1alpha1.MigrationPhasePreparing))
+		})
+
+		It("should not migrate if calico-node supports IPv6", func() {
+			deployCalicoNode(env("FELIX_IPV6SUPPORT", "true"))
+
+			Expect(getDualStackMigrationPhase(ctx, log, seedClient, network, dualStack)).To(BeEmpty())
+		})
+	})
+
+	Describe("#getNodesMigrationStatus", func() {
+		var shootClient client.Client
+
+		pod := func(nodeName string, ready bool, env ...corev1.EnvVar) *corev1.Pod {
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "calico-node-" + nodeName, Namespace: metav1.NamespaceSystem, Labels: map[string]string{"k8s-app": calico.CalicoNodeDaemonSetName}},
+				Spec:       corev1.PodSpec{NodeName: nodeName, Containers: []corev1.Container{{Name: calico.CalicoNodeDaemonSetName, Env: env}}},
+				Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}},
+			}
+		}
+
+		BeforeEach(func() {
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "c"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "d"}},
+				pod("a", true, env("FELIX_IPV6SUPPORT", "true")),
+				pod("b", true, env("FELIX_IPV6SUPPORT", "false")),
+				pod("c", false, env("FELIX_IPV6SUPPORT", "true")),
+			).Build()
+		})
+
+		It("should report the progress of each node", func() {
+			nodes, err := getNodesMigrationStatus(ctx, log, shootClient, isIPv6Enabled, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodes).To(ConsistOf(
+				calicov1alpha1.NodeMigrationStatus{Name: "a", Ready: true},
+				calicov1alpha1.NodeMigrationStatus{Name: "b", Message: ptr.To("calico-node pod has not been updated yet")},
+				calicov1alpha1.NodeMigrationStatus{Name: "c", Message: ptr.To("calico-node pod is not ready")},
+				calicov1alpha1.NodeMigrationStatus{Name: "d", Message: ptr.To("calico-node pod is not running")},
+			))
+
+			migration := &calicov1alpha1.MigrationStatus{Nodes: nodes}
+			Expect(migrationPercentage(migration)).To(Equal(int32(25)))
+		})
+
+		It("should consider the calico-node pods of worker pools", func() {
+			poolPod := pod("d", true, env("FELIX_IPV6SUPPORT", "true"))
+			poolPod.Labels = map[string]string{"k8s-app": calico.CalicoNodeWorkerPoolAppLabel, v1beta1constants.LabelWorkerPool: "pool"}
+			Expect(shootClient.Create(ctx, poolPod)).To(Succeed())
+
+			nodes, err := getNodesMigrationStatus(ctx, log, shootClient, isIPv6Enabled, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodes).To(ContainElement(calicov1alpha1.NodeMigrationStatus{Name: "d", Ready: true}))
+		})
+
+		It("should apply the node check to updated nodes", func() {
+			nodes, err := getNodesMigrationStatus(ctx, log, shootClient, isIPv6Enabled, checkNodeIPv6)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodes).To(ContainElement(calicov1alpha1.NodeMigrationStatus{Name: "a", Message: ptr.To(checkNodeIPv6(corev1.Node{}))}))
+		})
+	})
+
+	Describe("#checkNodeIPv6", func() {
+		var node corev1.Node
+
+		BeforeEach(func() {
+			node = corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "a", Annotations: map[string]string{annotationCalicoIPv6Address: "2001:db8::1/64"}},
+				Spec:       corev1.NodeSpec{PodCIDRs: []string{"100.96.0.0/24", "2001:db8:1::/64"}},
+				Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+					{Type: corev1.NodeInternalIP, Address: "10.250.0.1"},
+					{Type: corev1.NodeInternalIP, Address: "2001:db8::1"},
+				}},
+			}
+		})
+
+		It("should accept a node which is ready for dual-stack pods", func() {
+			Expect(checkNodeIPv6(node)).To(BeEmpty())
+		})
+
+		It("should require an IPv6 address", func() {
+			node.Status.Addresses = node.Status.Addresses[:1]
+			Expect(checkNodeIPv6(node)).To(Equal("node has no IPv6 address"))
+		})
+
+		It("should require an IPv6 pod CIDR", func() {
+			node.Spec.PodCIDRs = node.Spec.PodCIDRs[:1]
+			Expect(checkNodeIPv6(node)).To(Equal("node has no IPv6 pod CIDR"))
+		})
+
+		It("should require calico to set up IPv6 routing", func() {
+			node.Annotations = nil
+			Expect(checkNodeIPv6(node)).To(Equal("calico has not set up IPv6 routing for node"))
+		})
+	})
+
+	Describe("#checkNodeBPF", func() {
+		It("should accept a node whose kernel supports the eBPF dataplane", func() {
+			Expect(checkNodeBPF(corev1.Node{Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KernelVersion: "6.6.87-cloud-amd64"}}})).To(BeEmpty())
+		})
+
+		It("should reject a node whose kernel does not support the eBPF dataplane", func() {
+			Expect(checkNodeBPF(corev1.Node{Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{KernelVersion: "4.19.0-26-amd64"}}})).To(Equal("kernel 4.19.0-26-amd64 does not support the eBPF dataplane"))
+		})
+
+		It("should accept a node with an unknown kernel version", func() {
+			Expect(checkNodeBPF(corev1.Node{})).To(BeEmpty())
+		})
+	})
+
+	Describe("#driveMigration", func() {
+		var (
+			ready   = []calicov1alpha1.NodeMigrationStatus{{Name: "a", Ready: true}}
+			pending = []calicov1alpha1.NodeMigrationStatus{{Name: "a", Message: ptr.To("calico-node pod is not ready")}}
+		)
+
+		nodes := func(nodes []calicov1alpha1.NodeMigrationStatus) nodesCheck {
+			return func(context.Context, logr.Logger, client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+				return nodes, nil
+			}
+		}
+
+		It("should stay in the Preparing phase until all nodes are prepared", func() {
+			migration, err := driveMigration(ctx, log, nil, nodeMigration{migrationType: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, prepare: nodes(pending), check: nodes(ready)}, calicov1alpha1.MigrationPhasePreparing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).To(Equal(&calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhasePreparing, Nodes: pending}))
+		})
+
+		It("should not complete the migration in the reconciliation which continues in the Migrating phase", func() {
+			migration, err := driveMigration(ctx, log, nil, nodeMigration{migrationType: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, prepare: nodes(ready), check: nodes(ready)}, calicov1alpha1.MigrationPhasePreparing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).To(Equal(&calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhaseMigrating, Nodes: ready}))
+		})
+
+		It("should complete the migration once all nodes are ready in the Migrating phase", func() {
+			m := nodeMigration{migrationType: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, prepare: nodes(pending), check: nodes(pending)}
+			migration, err := driveMigration(ctx, log, nil, m, calicov1alpha1.MigrationPhaseMigrating)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).To(Equal(&calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeHostLocalToCalicoIPAM, Phase: calicov1alpha1.MigrationPhaseMigrating, Nodes: pending}))
+
+			m.check = nodes(ready)
+			Expect(driveMigration(ctx, log, nil, m, calicov1alpha1.MigrationPhaseMigrating)).To(BeNil())
+		})
+
+		It("should complete a migration without preparation in the given phase", func() {
+			m := nodeMigration{migrationType: calicov1alpha1.MigrationTypeIPv4ToDualStack, check: nodes(pending)}
+			migration, err := driveMigration(ctx, log, nil, m, calicov1alpha1.MigrationPhasePreparing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(migration).To(Equal(&calicov1alpha1.MigrationStatus{Type: calicov1alpha1.MigrationTypeIPv4ToDualStack, Phase: calicov1alpha1.MigrationPhasePreparing, Nodes: pending}))
+
+			m.check = nodes(ready)
+			Expect(driveMigration(ctx, log, nil, m, calicov1alpha1.MigrationPhasePreparing)).To(BeNil())
+		})
+	})
+
+	Describe("#envValue", func() {
+		It("should return the value of the environment variable in any container", func() {
+			containers := []corev1.Container{{Name: "bird-exporter"}, {Name: calico.CalicoNodeDaemonSetName, Env: []corev1.EnvVar{env("FELIX_BPFENABLED", "true")}}}
+			Expect(envValue(containers, "FELIX_BPFENABLED")).To(Equal("true"))
+			Expect(envValue(containers, "FELIX_IPV6SUPPORT")).To(BeEmpty())
+		})
+	})
+
+	Describe("#migrationProgress", func() {
+		It("should summarize the pending nodes", func() {
+			Expect(migrationProgress(&calicov1alpha1.MigrationStatus{Nodes: []calicov1alpha1.NodeMigrationStatus{
+				{Name: "a", Ready: true},
+				{Name: "b"},
+				{Name: "c"},
+			}})).To(Equal("1/3 nodes ready, pending: b, c"))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	chartspkg "github.com/gardener/gardener-extension-networking-calico/pkg/charts"
+)
+
+// ensureVethMTU derives the MTU of the pod interfaces from the MTU of the node network of the provider of the shoot, if
+// it is configured. The derived MTU is used unless the network config sets the MTU explicitly, in which case it must
+// not exceed the derived MTU.
+func (a *actuator) ensureVethMTU(
+	network *extensionsv1alpha1.Network,
+	networkConfig *calicov1alpha1.NetworkConfig,
+	cluster *extensionscontroller.Cluster,
+	kubeProxyEnabled bool,
+	kubeProxyMode *gardencorev1beta1.ProxyMode,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+) error {
+	underlayMTU, ok := a.underlayMTUs[cluster.Shoot.Spec.Provider.Type]
+	if !ok {
+		return nil
+	}
+
+	status, err := a.ComputeNetworkStatus(network, networkConfig, kubeProxyEnabled, kubeProxyMode, ipFamilies, migration)
+	if err != nil {
+		return err
+	}
+	recommendedMTU := chartspkg.RecommendedVethMTU(underlayMTU, status, networkConfig.WireguardEncryption)
+
+	if networkConfig.VethMTU == nil || *networkConfig.VethMTU == "0" {
+		networkConfig.VethMTU = ptr.To(strconv.Itoa(int(recommendedMTU)))
+	} else if err := validateVethMTU(*networkConfig.VethMTU, recommendedMTU, cluster.Shoot.Spec.Provider.Type, field.NewPath("vethMTU")); err != nil {
+		return err
+	}
+
+	for i, workerPool := range networkConfig.WorkerPools {
+		if workerPool.VethMTU == nil || *workerPool.VethMTU == "0" {
+			continue
+		}
+		if err := validateVethMTU(*workerPool.VethMTU, recommendedMTU, cluster.Shoot.Spec.Provider.Type, field.NewPath("workerPools").Index(i).Child("vethMTU")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateVethMTU(vethMTU string, recommendedMTU int32, providerType string, fldPath *field.Path) error {
+	mtu, err := strconv.Atoi(vethMTU)
+	if err != nil {
+		return field.Invalid(fldPath, vethMTU, fmt.Sprintf("invalid MTU: %v", err))
+	}
+	if mtu > int(recommendedMTU) {
+		return field.Invalid(fldPath, vethMTU, fmt.Sprintf("must not exceed %d, the MTU of the %s node network minus the encapsulation overhead", recommendedMTU, providerType))
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	"github.com/gardener/gardener/pkg/api/extensions/validation"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+// RenderResult is the result of the offline rendering of the calico chart for a Network resource.
+type RenderResult struct {
+	// Status is the provider status which would be reported for the Network resource.
+	Status *calicov1alpha1.NetworkStatus
+	// Values are the values of the calico chart.
+	Values map[string]interface{}
+	// Manifests are the rendered manifests of the calico chart, i.e. the content of the calico ManagedResource.
+	Manifests []byte
+}
+
+// Render runs the reconciliation of the given Network resource up to the rendering of the calico chart, without
+// accessing the seed or the shoot cluster. Hence, migrations of the pod network and rotations of the WireGuard keys are
+// not considered, and the desired state of the pod network is rendered. Only the UnderlayMTUs, Defaults, Constraints
+// and Profiles of the given options are used.
+func Render(network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster, opts AddOptions) (*RenderResult, error) {
+	if errList := validation.ValidateNetwork(network); len(errList) != 0 {
+		return nil, fmt.Errorf("invalid network resource: %w", errList.ToAggregate())
+	}
+
+	a := &actuator{
+		chartRendererFactory: extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		underlayMTUs:         opts.UnderlayMTUs,
+		defaults:             opts.Defaults,
+		constraints:          opts.Constraints,
+		profiles:             opts.Profiles,
+	}
+
+	network, networkConfig, ipFamilies, err := a.desiredNetworkConfig(network, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeProxyEnabled, kubeProxyMode, err := a.deriveNetworkConfig(network, networkConfig, cluster, ipFamilies, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := a.ComputeNetworkStatus(network, networkConfig, kubeProxyEnabled, kubeProxyMode, ipFamilies, nil)
+	if err != nil {
+		return nil, err
+	}
+	status.Profile = a.profileStatus(networkConfig)
+
+	values, manifests, err := a.renderCalicoChart(network, networkConfig, cluster, kubeProxyEnabled, kubeProxyMode, ipFamilies, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderResult{
+		Status:    status,
+		Values:    values,
+		Manifests: manifests,
+	}, nil
+}
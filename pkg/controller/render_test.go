@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
+)
+
+var _ = Describe("Render", func() {
+	var (
+		network *extensionsv1alpha1.Network
+		cluster *extensionscontroller.Cluster
+		opts    AddOptions
+	)
+
+	BeforeEach(func() {
+		network = &extensionsv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+			Spec: extensionsv1alpha1.NetworkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: "calico",
+					ProviderConfig: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","profile":"no-overlay"}`),
+					},
+				},
+				PodCIDR:     "100.96.0.0/11",
+				ServiceCIDR: "100.64.0.0/13",
+				IPFamilies:  []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4},
+			},
+		}
+		cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider:   gardencorev1beta1.Provider{Type: "aws"},
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.33.0"},
+				Networking: &gardencorev1beta1.Networking{Nodes: ptr.To("10.250.0.0/16")},
+			},
+		}}
+		opts = AddOptions{
+			UnderlayMTUs: map[string]int32{"aws": 9001},
+			Profiles: map[string]config.NetworkConfigProfile{
+				"no-overlay": {Version: "v1", NetworkConfig: calicov1alpha1.NetworkConfig{Overlay: &calicov1alpha1.Overlay{Enabled: false}}},
+			},
+		}
+	})
+
+	It("should derive the status and render the calico chart from the same values", func() {
+		result, err := Render(network, cluster, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(result.Status.Profile).To(Equal(&calicov1alpha1.ProfileStatus{Name: "no-overlay", Version: "v1"}))
+		Expect(result.Status.OverlayEnabled).To(BeFalse())
+		Expect(result.Status.VethMTU).To(Equal(ptr.To("9001")))
+
+		Expect(result.Values).To(HaveKeyWithValue("config", HaveKeyWithValue("veth_mtu", "9001")))
+		Expect(string(result.Manifests)).To(ContainSubstring("kind: DaemonSet"))
+		Expect(string(result.Manifests)).To(ContainSubstring("name: calico-node"))
+	})
+
+	It("should fail for an invalid network", func() {
+		network.Spec.Type = ""
+
+		_, err := Render(network, cluster, opts)
+		Expect(err).To(MatchError(ContainSubstring("invalid network resource")))
+	})
+})
@@ -6,6 +6,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -14,17 +15,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+	chartspkg "github.com/gardener/gardener-extension-networking-calico/pkg/charts"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/features"
 )
 
 func (a *actuator) updateProviderStatus(
 	ctx context.Context,
 	network *extensionsv1alpha1.Network,
 	config *calicov1alpha1.NetworkConfig,
+	kubeProxyEnabled bool,
+	kubeProxyMode *gardencorev1beta1.ProxyMode,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+	wireguard *calicov1alpha1.WireguardStatus,
 ) error {
-	status, err := a.ComputeNetworkStatus(config)
+	status, err := a.ComputeNetworkStatus(network, config, kubeProxyEnabled, kubeProxyMode, ipFamilies, migration)
 	if err != nil {
 		return err
 	}
+	status.Wireguard = wireguard
+	status.Profile = a.profileStatus(config)
 
 	patch := client.MergeFrom(network.DeepCopy())
 	network.Status.ProviderStatus = &runtime.RawExtension{Object: status}
@@ -33,25 +43,73 @@ func (a *actuator) updateProviderStatus(
 		100,
 		"Calico was configured successfully",
 	)
-	var ipFamilies []extensionsv1alpha1.IPFamily
+	if migration != nil {
+		network.Status.LastOperation = extensionscontroller.LastOperation(gardencorev1beta1.LastOperationTypeReconcile,
+			gardencorev1beta1.LastOperationStateProcessing,
+			migrationPercentage(migration),
+			fmt.Sprintf("Calico %s migration is in phase %s (%s)", migration.Type, migration.Phase, migrationProgress(migration)),
+		)
+	}
+	var statusIPFamilies []extensionsv1alpha1.IPFamily
 	if config.IPv4 != nil {
-		ipFamilies = append(ipFamilies, extensionsv1alpha1.IPFamilyIPv4)
+		statusIPFamilies = append(statusIPFamilies, extensionsv1alpha1.IPFamilyIPv4)
 	}
 	if config.IPv6 != nil {
-		ipFamilies = append(ipFamilies, extensionsv1alpha1.IPFamilyIPv6)
+		statusIPFamilies = append(statusIPFamilies, extensionsv1alpha1.IPFamilyIPv6)
 	}
-	network.Status.IPFamilies = ipFamilies
+	network.Status.IPFamilies = statusIPFamilies
+	network.Status.Conditions = a.wireguardConditions(network.Status.Conditions, config.WireguardEncryption, wireguard)
 
 	return a.client.Status().Patch(ctx, network, patch)
 }
 
-func (a *actuator) ComputeNetworkStatus(networkConfig *calicov1alpha1.NetworkConfig) (*calicov1alpha1.NetworkStatus, error) {
+// ComputeNetworkStatus computes the network status containing the effective calico configuration.
+func (a *actuator) ComputeNetworkStatus(
+	network *extensionsv1alpha1.Network,
+	networkConfig *calicov1alpha1.NetworkConfig,
+	kubeProxyEnabled bool,
+	kubeProxyMode *gardencorev1beta1.ProxyMode,
+	ipFamilies []extensionsv1alpha1.IPFamily,
+	migration *calicov1alpha1.MigrationStatus,
+) (*calicov1alpha1.NetworkStatus, error) {
+	status, err := chartspkg.ComputeNetworkStatus(network, networkConfig, kubeProxyEnabled, kubeProxyMode, features.FeatureGate.Enabled(features.NonPrivilegedCalicoNode), ipFamilies, migration)
+	if err != nil {
+		return nil, err
+	}
+	status.TypeMeta = StatusTypeMeta
+	status.Migration = migration
+
+	return status, nil
+}
 
-	var (
-		status = &calicov1alpha1.NetworkStatus{
-			TypeMeta: StatusTypeMeta,
-		}
-	)
+// getMigrationFromStatus returns the migration reported in the provider status of the given Network resource, if any.
+func getMigrationFromStatus(network *extensionsv1alpha1.Network) (*calicov1alpha1.MigrationStatus, error) {
+	status, err := getProviderStatus(network)
+	if err != nil || status == nil {
+		return nil, err
+	}
+	return status.Migration, nil
+}
+
+// getWireguardKeyRotationFromStatus returns the last completed rotation of the WireGuard keys reported in the provider
+// status of the given Network resource, if any.
+func getWireguardKeyRotationFromStatus(network *extensionsv1alpha1.Network) (*string, error) {
+	status, err := getProviderStatus(network)
+	if err != nil || status == nil || status.Wireguard == nil {
+		return nil, err
+	}
+	return status.Wireguard.KeyRotation, nil
+}
+
+// getProviderStatus decodes the provider status of the given Network resource. It returns nil if the status is not set.
+func getProviderStatus(network *extensionsv1alpha1.Network) (*calicov1alpha1.NetworkStatus, error) {
+	if network.Status.ProviderStatus == nil || network.Status.ProviderStatus.Raw == nil {
+		return nil, nil
+	}
 
+	status := &calicov1alpha1.NetworkStatus{}
+	if _, _, err := decoder.Decode(network.Status.ProviderStatus.Raw, nil, status); err != nil {
+		return nil, fmt.Errorf("could not decode provider status: %w", err)
+	}
 	return status, nil
 }
@@ -13,6 +13,7 @@ import (
 	apiscalico "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico"
 	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
 	calicovalidation "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/validation"
+	"github.com/gardener/gardener-extension-networking-calico/pkg/apis/config"
 )
 
 // ValidateNetworkConfig validates the given network configuration.
@@ -28,3 +29,18 @@ func ValidateNetworkConfig(networkConfig *calicov1alpha1.NetworkConfig, ipFamili
 
 	return nil
 }
+
+// ValidateNetworkConfigConstraints validates the given network configuration against the given constraints of the
+// operator for the shoots of the given provider type.
+func ValidateNetworkConfigConstraints(networkConfig *calicov1alpha1.NetworkConfig, constraints *config.NetworkConfigConstraints, providerType string) error {
+	internalNetworkConfig := &apiscalico.NetworkConfig{}
+	if err := calicov1alpha1.Convert_v1alpha1_NetworkConfig_To_calico_NetworkConfig(networkConfig, internalNetworkConfig, nil); err != nil {
+		return fmt.Errorf("could not convert network config: %w", err)
+	}
+
+	if errList := calicovalidation.ValidateNetworkConfigConstraints(internalNetworkConfig, constraints, providerType, field.NewPath("spec", "providerConfig")); len(errList) != 0 {
+		return fmt.Errorf("network config violates the constraints of the operator: %w", errList.ToAggregate())
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+const (
+	// ConditionTypeWireguardEncryptionReady is the type of the Network condition which indicates whether the WireGuard
+	// encryption is in effect on all nodes.
+	ConditionTypeWireguardEncryptionReady gardencorev1beta1.ConditionType = "WireguardEncryptionReady"
+
+	// annotationCalicoWireguardPublicKey is the node annotation set by calico-node with the public key of the IPv4
+	// WireGuard interface.
+	annotationCalicoWireguardPublicKey = "projectcalico.org/WireguardPublicKey"
+	// annotationCalicoWireguardPublicKeyV6 is the node annotation set by calico-node with the public key of the IPv6
+	// WireGuard interface.
+	annotationCalicoWireguardPublicKeyV6 = "projectcalico.org/WireguardPublicKeyV6"
+)
+
+// minWireguardKernelVersion is the first Linux version which ships WireGuard in the kernel.
+var minWireguardKernelVersion = semver.MustParse("5.6")
+
+// getWireguardStatus checks the shoot nodes for the WireGuard public keys published by calico-node for every IP family
+// of the shoot. It returns nil if the nodes cannot be checked.
+func (a *actuator) getWireguardStatus(ctx context.Context, log logr.Logger, cluster *extensionscontroller.Cluster, ipFamilies []extensionsv1alpha1.IPFamily) *calicov1alpha1.WireguardStatus {
+	shootClient, err := a.getShootClient(ctx, cluster)
+	if err != nil {
+		log.Info("Cannot check WireGuard encryption of nodes", "error", err)
+		return nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		log.Info("Cannot check WireGuard encryption of nodes", "error", fmt.Errorf("failed to list nodes: %w", err))
+		return nil
+	}
+
+	status := &calicov1alpha1.WireguardStatus{}
+	for _, node := range nodeList.Items {
+		if !isWireguardSupported(node) {
+			status.UnsupportedNodes = append(status.UnsupportedNodes, node.Name)
+		}
+		if checkNodeWireguardKeys(node, ipFamilies) != "" {
+			status.PendingNodes++
+		} else {
+			status.EncryptedNodes++
+		}
+	}
+
+	return status
+}
+
+// ensureWireguardKeyRotation drives the rotation of the WireGuard keys requested by the shoot annotation. calico-node is
+// restarted in a rolling fashion with the identifier of the rotation, which deletes the WireGuard interfaces of a node
+// once, so that felix generates new keys. The public keys of the nodes are recorded when the rotation starts. The
+// rotation is completed once every node runs a restarted calico-node pod which is ready and has published new public
+// keys for every IP family of the shoot.
+// It returns the progress of the rotation if it is not completed yet.
+func (a *actuator) ensureWireguardKeyRotation(ctx context.Context, log logr.Logger, network *extensionsv1alpha1.Network, cluster *extensionscontroller.Cluster, keyRotation string, ipFamilies []extensionsv1alpha1.IPFamily) (*calicov1alpha1.MigrationStatus, error) {
+	return a.ensureMigration(ctx, log, cluster, nodeMigration{
+		migrationType: calicov1alpha1.MigrationTypeWireguardKeyRotation,
+		check: func(ctx context.Context, log logr.Logger, shootClient client.Client) ([]calicov1alpha1.NodeMigrationStatus, error) {
+			previousPublicKeys, err := getPreviousWireguardPublicKeys(ctx, shootClient, network, ipFamilies)
+			if err != nil {
+				return nil, err
+			}
+
+			nodes, err := getNodesMigrationStatus(ctx, log, shootClient, func(containers []corev1.Container) bool {
+				return envValue(containers, "WIREGUARD_KEY_ROTATION") == keyRotation
+			}, func(node corev1.Node) string {
+				if reason := checkNodeWireguardKeys(node, ipFamilies); reason != "" {
+					return reason
+				}
+				if slices.ContainsFunc(wireguardPublicKeys(node, ipFamilies), func(key string) bool {
+					return slices.Contains(previousPublicKeys[node.Name], key)
+				}) {
+					return "calico has not published the new WireGuard public keys of node yet"
+				}
+				return ""
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range nodes {
+				nodes[i].PreviousWireguardPublicKeys = previousPublicKeys[nodes[i].Name]
+			}
+			return nodes, nil
+		},
+	}, calicov1alpha1.MigrationPhaseMigrating)
+}
+
+// getPreviousWireguardPublicKeys returns the public keys of the nodes before the rotation of the WireGuard keys by node
+// name. They are taken from the ongoing rotation reported in the provider status of the given Network resource, or from
+// the nodes if the rotation starts. Nodes which join during the rotation have no previous public keys.
+func getPreviousWireguardPublicKeys(ctx context.Context, shootClient client.Client, network *extensionsv1alpha1.Network, ipFamilies []extensionsv1alpha1.IPFamily) (map[string][]string, error) {
+	migration, err := getMigrationFromStatus(network)
+	if err != nil {
+		return nil, err
+	}
+
+	previousPublicKeys := map[string][]string{}
+	if migration != nil && migration.Type == calicov1alpha1.MigrationTypeWireguardKeyRotation {
+		for _, node := range migration.Nodes {
+			previousPublicKeys[node.Name] = node.PreviousWireguardPublicKeys
+		}
+		return previousPublicKeys, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	for _, node := range nodeList.Items {
+		previousPublicKeys[node.Name] = wireguardPublicKeys(node, ipFamilies)
+	}
+	return previousPublicKeys, nil
+}
+
+// checkNodeWireguardKeys returns why the given node has no WireGuard keys, or an empty string if it has keys for all
+// given IP families.
+func checkNodeWireguardKeys(node corev1.Node, ipFamilies []extensionsv1alpha1.IPFamily) string {
+	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) && node.Annotations[annotationCalicoWireguardPublicKey] == "" {
+		return "calico has not published the IPv4 WireGuard public key of node"
+	}
+	if slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) && node.Annotations[annotationCalicoWireguardPublicKeyV6] == "" {
+		return "calico has not published the IPv6 WireGuard public key of node"
+	}
+	return ""
+}
+
+// wireguardPublicKeys returns the WireGuard public keys published by calico for the given IP families of the given node.
+func wireguardPublicKeys(node corev1.Node, ipFamilies []extensionsv1alpha1.IPFamily) []string {
+	var keys []string
+	if key := node.Annotations[annotationCalicoWireguardPublicKey]; key != "" && slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv4) {
+		keys = append(keys, key)
+	}
+	if key := node.Annotations[annotationCalicoWireguardPublicKeyV6]; key != "" && slices.Contains(ipFamilies, extensionsv1alpha1.IPFamilyIPv6) {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// isWireguardSupported returns whether the kernel of the given node supports WireGuard. Nodes with an unknown kernel
+// version are assumed to support it.
+func isWireguardSupported(node corev1.Node) bool {
+	return isKernelVersionAtLeast(node, minWireguardKernelVersion)
+}
+
+// wireguardConditions returns the given conditions with the WireguardEncryptionReady condition updated according to
+// the given WireGuard status. The condition is removed if WireGuard encryption is disabled.
+func (a *actuator) wireguardConditions(conditions []gardencorev1beta1.Condition, enabled bool, wireguard *calicov1alpha1.WireguardStatus) []gardencorev1beta1.Condition {
+	if !enabled {
+		return gardencorev1beta1helper.RemoveConditions(conditions, ConditionTypeWireguardEncryptionReady)
+	}
+
+	condition := gardencorev1beta1helper.GetOrInitConditionWithClock(a.clock, conditions, ConditionTypeWireguardEncryptionReady)
+	switch {
+	case wireguard == nil:
+		condition = gardencorev1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionUnknown, "NodesNotChecked", "The WireGuard encryption of the nodes could not be checked.")
+	case len(wireguard.UnsupportedNodes) > 0:
+		condition = gardencorev1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionFalse, "KernelNotSupported",
+			fmt.Sprintf("The kernel of nodes %s does not support WireGuard.", strings.Join(wireguard.UnsupportedNodes, ", ")))
+	case wireguard.PendingNodes > 0:
+		condition = gardencorev1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionFalse, "NodesPending",
+			fmt.Sprintf("%d of %d nodes are encrypted with WireGuard.", wireguard.EncryptedNodes, wireguard.EncryptedNodes+wireguard.PendingNodes))
+	default:
+		condition = gardencorev1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionTrue, "NodesEncrypted",
+			fmt.Sprintf("All %d nodes are encrypted with WireGuard.", wireguard.EncryptedNodes))
+	}
+
+	return gardencorev1beta1helper.MergeConditions(conditions, condition)
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	calicov1alpha1 "github.com/gardener/gardener-extension-networking-calico/pkg/apis/calico/v1alpha1"
+)
+
+var _ = Describe("WireGuard", func() {
+	var (
+		ctx         = context.Background()
+		ipv4        = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+		dualStack   = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+		nodeA       corev1.Node
+		nodeB       corev1.Node
+		network     *extensionsv1alpha1.Network
+		shootClient client.Client
+	)
+
+	BeforeEach(func() {
+		nodeA = corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "a", Annotations: map[string]string{
+			annotationCalicoWireguardPublicKey:   "a-ipv4",
+			annotationCalicoWireguardPublicKeyV6: "a-ipv6",
+		}}}
+		nodeB = corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "b"}}
+		network = &extensionsv1alpha1.Network{}
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(&nodeA, &nodeB).Build()
+	})
+
+	Describe("#wireguardPublicKeys", func() {
+		It("should return the public keys of the IP families of the shoot", func() {
+			Expect(wireguardPublicKeys(nodeA, ipv4)).To(Equal([]string{"a-ipv4"}))
+			Expect(wireguardPublicKeys(nodeA, dualStack)).To(Equal([]string{"a-ipv4", "a-ipv6"}))
+			Expect(wireguardPublicKeys(nodeB, dualStack)).To(BeEmpty())
+		})
+	})
+
+	Describe("#wireguardConditions", func() {
+		It("should report nodes with an unsupported kernel in the condition", func() {
+			a := &actuator{clock: testclock.NewFakeClock(time.Now())}
+
+			conditions := a.wireguardConditions(nil, true, &calicov1alpha1.WireguardStatus{EncryptedNodes: 2, UnsupportedNodes: []string{"b"}})
+			Expect(conditions).To(ConsistOf(And(
+				HaveField("Type", ConditionTypeWireguardEncryptionReady),
+				HaveField("Status", gardencorev1beta1.ConditionFalse),
+				HaveField("Reason", "KernelNotSupported"),
+			)))
+		})
+	})
+
+	Describe("#getPreviousWireguardPublicKeys", func() {
+		It("should record the public keys of the nodes when the rotation starts", func() {
+			Expect(getPreviousWireguardPublicKeys(ctx, shootClient, network, dualStack)).To(Equal(map[string][]string{
+				"a": {"a-ipv4", "a-ipv6"},
+				"b": nil,
+			}))
+		})
+
+		It("should keep the public keys recorded for the ongoing rotation", func() {
+			status, err := json.Marshal(&calicov1alpha1.NetworkStatus{
+				TypeMeta: StatusTypeMeta,
+				Migration: &calicov1alpha1.MigrationStatus{
+					Type:  calicov1alpha1.MigrationTypeWireguardKeyRotation,
+					Phase: calicov1alpha1.MigrationPhaseMigrating,
+					Nodes: []calicov1alpha1.NodeMigrationStatus{{Name: "a", PreviousWireguardPublicKeys: []string{"a-old"}}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			network.Status.ProviderStatus = &runtime.RawExtension{Raw: status}
+
+			Expect(getPreviousWireguardPublicKeys(ctx, shootClient, network, dualStack)).To(Equal(map[string][]string{
+				"a": {"a-old"},
+			}))
+		})
+	})
+})
@@ -18,14 +18,32 @@ const (
 	// owner @docktofuture
 	// alpha: v1.38.0
 	SeamlessOverlaySwitch featuregate.Feature = "SeamlessOverlaySwitch"
+	// SeamlessEncapsulationMigration switches the default IPv4 pool of running shoots from IPIP to VXLAN encapsulation
+	// only once the VXLAN tunnel interfaces are ready on all nodes.
+	// alpha: v1.60.0
+	SeamlessEncapsulationMigration featuregate.Feature = "SeamlessEncapsulationMigration"
+	// SeamlessIPAMMigration migrates the IPAM of running IPv4 shoots from host-local to calico-ipam node by node.
+	// alpha: v1.60.0
+	SeamlessIPAMMigration featuregate.Feature = "SeamlessIPAMMigration"
+	// SeamlessDataplaneMigration sequences switches between the iptables and eBPF dataplanes with kube-proxy.
+	// alpha: v1.60.0
+	SeamlessDataplaneMigration featuregate.Feature = "SeamlessDataplaneMigration"
+	// SeamlessDualStackMigration enables IPv6 for the pods of shoots migrated from IPv4 to dual-stack only once calico
+	// is ready for IPv6 on all nodes.
+	// alpha: v1.60.0
+	SeamlessDualStackMigration featuregate.Feature = "SeamlessDualStackMigration"
 )
 
 var (
 	// FeatureGate is a shared global FeatureGate for networking-calico extension flags.
 	FeatureGate  = featuregate.NewFeatureGate()
 	featureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-		NonPrivilegedCalicoNode: {Default: false, PreRelease: featuregate.Alpha},
-		SeamlessOverlaySwitch:   {Default: false, PreRelease: featuregate.Alpha},
+		NonPrivilegedCalicoNode:        {Default: false, PreRelease: featuregate.Alpha},
+		SeamlessOverlaySwitch:          {Default: false, PreRelease: featuregate.Alpha},
+		SeamlessEncapsulationMigration: {Default: false, PreRelease: featuregate.Alpha},
+		SeamlessIPAMMigration:          {Default: false, PreRelease: featuregate.Alpha},
+		SeamlessDataplaneMigration:     {Default: false, PreRelease: featuregate.Alpha},
+		SeamlessDualStackMigration:     {Default: false, PreRelease: featuregate.Alpha},
 	}
 )
 